@@ -0,0 +1,28 @@
+# =============================================================================
+# Dremio Pipe Load Files Action Example
+# =============================================================================
+
+resource "dremio_pipe" "events" {
+  name             = "events_pipe"
+  table            = ["lake", "raw", "events"]
+  storage_location = "@s3_landing/events"
+  file_format      = "JSON"
+
+  # Load the files written before the pipe existed once it is created
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.dremio_pipe_load_files.backfill]
+    }
+  }
+}
+
+action "dremio_pipe_load_files" "backfill" {
+  config {
+    pipe_name = "events_pipe"
+    files = [
+      { path = "2026/10/01/events.json", size = "80 MB" },
+      { path = "2026/10/02/events.json", size = "75 MB" },
+    ]
+  }
+}
@@ -0,0 +1,32 @@
+# =============================================================================
+# Dremio Dataset Lineage Data Source Example
+# =============================================================================
+
+data "dremio_table" "orders" {
+  path = ["lake", "sales", "orders"]
+}
+
+# Read the sources, parents and children of the orders table
+data "dremio_dataset_lineage" "orders" {
+  dataset_id = data.dremio_table.orders.id
+}
+
+# Warn when views still depend on the table
+check "orders_has_no_dependent_views" {
+  assert {
+    condition = length(data.dremio_dataset_lineage.orders.children) == 0
+    error_message = format(
+      "Views still depend on the orders table: %s",
+      join(", ", [for c in data.dremio_dataset_lineage.orders.children : join(".", c.path)]),
+    )
+  }
+}
+
+# Document the data flow
+output "orders_downstream" {
+  value = [for c in data.dremio_dataset_lineage.orders.children : join(".", c.path)]
+}
+
+output "orders_sources" {
+  value = [for s in data.dremio_dataset_lineage.orders.sources : "${join(".", s.path)} (${s.type})"]
+}
@@ -0,0 +1,31 @@
+# =============================================================================
+# Dremio Job Data Source Example
+# =============================================================================
+
+# Run a smoke-test query against the new view
+data "dremio_sql_query" "smoke_test" {
+  sql       = "SELECT region, SUM(amount) FROM analytics.sales_by_region GROUP BY region"
+  row_limit = 1
+
+  depends_on = [dremio_view.sales_by_region, dremio_reflection.sales_by_region]
+}
+
+# Read the job that ran it
+data "dremio_job" "smoke_test" {
+  job_id = data.dremio_sql_query.smoke_test.job_id
+}
+
+# Confirm the query was accelerated by a Reflection
+check "sales_by_region_is_accelerated" {
+  assert {
+    condition     = data.dremio_job.smoke_test.accelerated
+    error_message = "The smoke-test query on analytics.sales_by_region was not accelerated by a Reflection."
+  }
+}
+
+output "smoke_test_reflections" {
+  value = [
+    for r in data.dremio_job.smoke_test.reflections : r.reflection_id
+    if r.relationship == "CHOSEN"
+  ]
+}
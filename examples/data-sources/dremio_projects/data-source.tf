@@ -0,0 +1,21 @@
+# =============================================================================
+# Dremio Projects Data Source Example
+# =============================================================================
+
+# List the active projects of the organization
+data "dremio_projects" "active" {
+  state = "ACTIVE"
+}
+
+# Create the same space in every active project
+resource "dremio_space" "analytics" {
+  for_each = { for p in data.dremio_projects.active.projects : p.name => p.id }
+
+  project_id = each.value
+  name       = "analytics"
+}
+
+output "project_ids" {
+  value       = [for p in data.dremio_projects.active.projects : p.id]
+  description = "IDs of the active projects"
+}
@@ -0,0 +1,31 @@
+# =============================================================================
+# Dremio Reflection Recommendations Data Source Example
+# =============================================================================
+
+# Recommendations for the queries of specific jobs
+data "dremio_reflection_recommendations" "slow_jobs" {
+  job_ids = ["1a2b3c4d-0000-0000-0000-000000000001"]
+}
+
+# Aggregation recommendation for a dataset
+data "dremio_reflection_recommendations" "trips" {
+  dataset_id      = data.dremio_table.trips.id
+  reflection_type = "AGGREGATION"
+}
+
+# Codify a recommendation as a regular Reflection
+resource "dremio_reflection" "trips_recommended" {
+  name             = "trips_recommended"
+  type             = data.dremio_reflection_recommendations.trips.recommendations[0].type
+  dataset_id       = data.dremio_table.trips.id
+  dimension_fields = data.dremio_reflection_recommendations.trips.recommendations[0].dimension_fields
+  measure_fields   = data.dremio_reflection_recommendations.trips.recommendations[0].measure_fields
+}
+
+# Usage-based recommendations computed from the project query history
+data "dremio_reflection_recommendations" "usage" {}
+
+output "usage_recommendation_ids" {
+  value       = [for r in data.dremio_reflection_recommendations.usage.recommendations : r.recommendation_id]
+  description = "IDs of the usage-based recommendations"
+}
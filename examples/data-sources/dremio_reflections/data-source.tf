@@ -0,0 +1,43 @@
+# =============================================================================
+# Dremio Reflections Data Source Example
+# =============================================================================
+
+locals {
+  managed_reflection_ids = [
+    dremio_reflection.sales_raw.id,
+    dremio_reflection.sales_by_region.id,
+  ]
+}
+
+# Fail the run when a Reflection managed here failed or cannot accelerate queries
+data "dremio_reflections" "all" {
+  lifecycle {
+    postcondition {
+      condition = alltrue([
+        for r in self.reflections : r.combined_status != "FAILED" && !startswith(r.combined_status, "CANNOT_ACCELERATE")
+        if contains(local.managed_reflection_ids, r.id)
+      ])
+      error_message = "A managed Reflection is in the FAILED or CANNOT_ACCELERATE state."
+    }
+  }
+}
+
+# Reflections of a single dataset
+data "dremio_reflections" "sales" {
+  dataset_path = ["analytics", "sales"]
+}
+
+# Warn without failing the run
+check "sales_reflections_refreshed" {
+  assert {
+    condition     = alltrue([for r in data.dremio_reflections.sales.reflections : r.failure_count == 0])
+    error_message = "A Reflection of analytics.sales has failed refreshes."
+  }
+}
+
+output "unhealthy_reflections" {
+  value = {
+    for r in data.dremio_reflections.all.reflections : r.name => r.last_failure_message
+    if r.failure_count > 0
+  }
+}
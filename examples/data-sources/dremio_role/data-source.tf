@@ -0,0 +1,13 @@
+# =============================================================================
+# Dremio Role Data Source Example
+# =============================================================================
+
+# Look up a role by name
+data "dremio_role" "public" {
+  name = "PUBLIC"
+}
+
+output "role_id" {
+  value       = data.dremio_role.public.id
+  description = "ID of the role"
+}
@@ -0,0 +1,54 @@
+# =============================================================================
+# Dremio Search Data Source Example
+# =============================================================================
+
+# Find the tables that mention PII
+data "dremio_search" "pii" {
+  query       = "pii"
+  filter      = "category in [\"TABLE\"]"
+  max_results = 500
+}
+
+locals {
+  # Keep the tables labeled "pii", keyed by their dotted path
+  pii_tables = {
+    for o in data.dremio_search.pii.catalog_objects : join(".", o.path) => o.path
+    if contains(o.labels, "pii")
+  }
+}
+
+# Look up the ID of each table
+data "dremio_table" "pii" {
+  for_each = local.pii_tables
+
+  path = each.value
+}
+
+# Restrict each table to the privacy team
+resource "dremio_grants" "pii" {
+  for_each = data.dremio_table.pii
+
+  catalog_object_id = each.value.id
+
+  grants = [
+    {
+      id           = "privacy-team-role-uuid"
+      grantee_type = "ROLE"
+      privileges   = ["SELECT"]
+    }
+  ]
+}
+
+# Recent failed jobs that mention the orders table
+data "dremio_search" "failed_jobs" {
+  query  = "orders"
+  filter = "category in [\"JOB\"] && job_state in [\"FAILED\"]"
+}
+
+output "failed_job_ids" {
+  value = [for j in data.dremio_search.failed_jobs.jobs : j.id]
+}
+
+output "search_truncated" {
+  value = data.dremio_search.pii.truncated
+}
@@ -0,0 +1,17 @@
+# =============================================================================
+# Dremio Space Data Source Example
+# =============================================================================
+
+data "dremio_space" "datasource_space_example" {
+  name = "analytics"
+}
+
+output "data_space_id" {
+  value       = data.dremio_space.datasource_space_example.id
+  description = "ID of the space"
+}
+
+output "data_space_children" {
+  value       = [for child in data.dremio_space.datasource_space_example.children : join(".", child.path)]
+  description = "Paths of the objects in the space"
+}
@@ -0,0 +1,22 @@
+# =============================================================================
+# Dremio SQL Query Data Source Example
+# =============================================================================
+
+# Read the list of regions from a configuration table
+data "dremio_sql_query" "regions" {
+  sql       = "SELECT region, bucket FROM config.regions WHERE enabled = true"
+  timeout   = "2m"
+  row_limit = 100
+}
+
+# Create one folder per region
+resource "dremio_folder" "region" {
+  for_each = { for row in data.dremio_sql_query.regions.rows : row.region => row }
+
+  path = ["lake", "regions", each.key]
+}
+
+output "regions" {
+  value       = jsondecode(data.dremio_sql_query.regions.rows_json)
+  description = "Regions, with their original value types"
+}
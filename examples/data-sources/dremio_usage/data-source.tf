@@ -0,0 +1,29 @@
+# =============================================================================
+# Dremio Usage Data Source Example
+# =============================================================================
+
+locals {
+  # Previous calendar month
+  month_start = formatdate("YYYY-MM-01'T'00:00:00Z", timeadd(formatdate("YYYY-MM-01'T'00:00:00Z", timestamp()), "-24h"))
+  month_end   = formatdate("YYYY-MM-01'T'00:00:00Z", timestamp())
+}
+
+# Consumption of each engine over the previous month
+data "dremio_usage" "last_month" {
+  start_time = local.month_start
+  end_time   = local.month_end
+  group_by   = "ENGINE"
+}
+
+# Export the consumption next to the engines that caused it
+output "engine_consumption" {
+  value = {
+    for engine_name, engine in dremio_engine.this : engine_name => sum(concat([0], [
+      for u in data.dremio_usage.last_month.usage : u.usage if u.id == engine.id
+    ]))
+  }
+}
+
+output "total_dcus" {
+  value = data.dremio_usage.last_month.total_usage
+}
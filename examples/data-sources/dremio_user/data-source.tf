@@ -0,0 +1,13 @@
+# =============================================================================
+# Dremio User Data Source Example
+# =============================================================================
+
+# Look up a user by email
+data "dremio_user" "jdoe" {
+  email = "jdoe@example.com"
+}
+
+output "user_roles" {
+  value       = [for role in data.dremio_user.jdoe.roles : role.name]
+  description = "Roles the user is a direct member of"
+}
@@ -0,0 +1,29 @@
+# =============================================================================
+# Dremio Personal Access Token Ephemeral Resource Example
+# =============================================================================
+
+data "dremio_user" "dbt" {
+  user_name = "dbt-service@acme.com"
+}
+
+# Create a token for the dbt service user that lives for one hour at most,
+# and is revoked as soon as Terraform is done with it
+ephemeral "dremio_personal_access_token" "dbt" {
+  user_id                = data.dremio_user.dbt.id
+  label                  = "terraform-ci"
+  milliseconds_to_expire = 3600000
+}
+
+# Manage objects as the dbt service user, so that they are owned by it
+provider "dremio" {
+  alias                 = "dbt"
+  host                  = "https://api.dremio.cloud"
+  type                  = "cloud"
+  project_id            = var.dremio_project_id
+  personal_access_token = ephemeral.dremio_personal_access_token.dbt.token
+}
+
+resource "dremio_space" "dbt" {
+  provider = dremio.dbt
+  name     = "dbt"
+}
@@ -0,0 +1,13 @@
+# =============================================================================
+# Dremio Arctic Catalog Resource Example
+# =============================================================================
+
+# Create an Arctic catalog for the lakehouse tables
+resource "dremio_arctic_catalog" "lakehouse" {
+  name = "lakehouse"
+}
+
+output "arctic_catalog_id" {
+  value       = dremio_arctic_catalog.lakehouse.id
+  description = "ID of the Arctic catalog"
+}
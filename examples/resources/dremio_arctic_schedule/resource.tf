@@ -0,0 +1,28 @@
+# =============================================================================
+# Dremio Arctic Schedule Resource Example
+# =============================================================================
+
+resource "dremio_arctic_catalog" "lakehouse" {
+  name = "lakehouse"
+}
+
+# Compact small files every night at 02:00 UTC
+resource "dremio_arctic_schedule" "nightly_optimize" {
+  catalog_id      = dremio_arctic_catalog.lakehouse.id
+  name            = "nightly-optimize"
+  cron_expression = "0 0 2 * * ?"
+  task_type       = "OPTIMIZE"
+}
+
+# Remove snapshots older than three days every Sunday, paused for now
+resource "dremio_arctic_schedule" "weekly_vacuum" {
+  catalog_id      = dremio_arctic_catalog.lakehouse.id
+  name            = "weekly-vacuum"
+  cron_expression = "0 0 3 ? * SUN"
+  task_type       = "VACUUM"
+  enabled         = false
+
+  parameters = jsonencode({
+    retentionPeriodMinutes = 4320
+  })
+}
@@ -0,0 +1,15 @@
+# =============================================================================
+# Dremio Billing Account Resource Example
+# =============================================================================
+
+# Adopt the existing billing account of the organization
+resource "dremio_billing_account" "main" {
+  id          = var.billing_account_id
+  name        = "Analytics Platform"
+  description = "Consumption of the analytics projects"
+
+  metadata = jsonencode({
+    cost_center = "CC-1234"
+    owner       = "data-platform"
+  })
+}
@@ -0,0 +1,22 @@
+# =============================================================================
+# Dremio Catalog Branch Resource Example
+# =============================================================================
+
+# Branch off main to stage changes before promoting them to production
+resource "dremio_catalog_branch" "staging" {
+  source      = "lakehouse"
+  name        = "staging"
+  from_branch = "main"
+}
+
+# Create a view on the staging branch
+resource "dremio_view" "orders_summary" {
+  path = ["lakehouse", "marts", "orders_summary"]
+  sql  = "SELECT region, SUM(amount) AS total FROM lakehouse.raw.orders GROUP BY region"
+  ref  = dremio_catalog_branch.staging.name
+}
+
+output "staging_hash" {
+  value       = dremio_catalog_branch.staging.hash
+  description = "Commit hash the staging branch points to"
+}
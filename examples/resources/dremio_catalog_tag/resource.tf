@@ -0,0 +1,17 @@
+# =============================================================================
+# Dremio Catalog Tag Resource Example
+# =============================================================================
+
+# Tag the current head of main as a release
+resource "dremio_catalog_tag" "release" {
+  source      = "lakehouse"
+  name        = "release-2026-10"
+  from_branch = "main"
+}
+
+# Tag a specific commit
+resource "dremio_catalog_tag" "audit" {
+  source    = "lakehouse"
+  name      = "audit-q3"
+  from_hash = "7f643f2b9cf250ce1f5d6ff4397237b705d866fbf34d714b2a2bc3e0f3f4b4a5"
+}
@@ -0,0 +1,49 @@
+# =============================================================================
+# Dremio Cloud Resource Example
+# =============================================================================
+
+# AWS compute environment
+resource "dremio_cloud" "aws" {
+  name = "aws-us-west-2"
+
+  aws = {
+    region               = "us-west-2"
+    vpc_id               = "vpc-0123456789abcdef0"
+    subnet_ids           = ["subnet-0123456789abcdef0", "subnet-0fedcba9876543210"]
+    role_arn             = "arn:aws:iam::123456789012:role/dremio-compute"
+    instance_profile_arn = "arn:aws:iam::123456789012:instance-profile/dremio-engines"
+  }
+
+  cloud_tags = [
+    { key = "cost-center", value = "CC-1234" },
+    { key = "managed-by", value = "terraform" },
+  ]
+}
+
+# Azure compute environment
+resource "dremio_cloud" "azure" {
+  name = "azure-eastus"
+
+  azure = {
+    tenant_id       = var.azure_tenant_id
+    subscription_id = var.azure_subscription_id
+    resource_group  = "dremio-compute"
+    region          = "eastus"
+    vnet_id         = "/subscriptions/${var.azure_subscription_id}/resourceGroups/dremio-compute/providers/Microsoft.Network/virtualNetworks/dremio"
+    subnet_id       = "/subscriptions/${var.azure_subscription_id}/resourceGroups/dremio-compute/providers/Microsoft.Network/virtualNetworks/dremio/subnets/engines"
+    client_id       = var.azure_client_id
+  }
+}
+
+# Project running its engines in the AWS cloud
+resource "dremio_project" "finance" {
+  name          = "finance"
+  cloud_id      = dremio_cloud.aws.id
+  project_store = "s3://acme-dremio-finance"
+
+  credentials = {
+    type                 = "IAM_ROLE"
+    role_arn             = "arn:aws:iam::123456789012:role/dremio-finance"
+    instance_profile_arn = "arn:aws:iam::123456789012:instance-profile/dremio-finance"
+  }
+}
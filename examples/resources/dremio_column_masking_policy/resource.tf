@@ -0,0 +1,19 @@
+# =============================================================================
+# Dremio Column Masking Policy Resource Example
+# =============================================================================
+
+# UDF returning the masked value of a column
+resource "dremio_udf" "mask_ssn" {
+  path              = ["governance", "mask_ssn"]
+  is_scalar         = true
+  function_arg_list = "ssn VARCHAR"
+  function_body     = "SELECT CASE WHEN is_member('hr') THEN ssn ELSE 'XXX-XX-' || RIGHT(ssn, 4) END"
+  return_type       = "VARCHAR"
+}
+
+# Mask the ssn column of the employees table
+resource "dremio_column_masking_policy" "employees_ssn" {
+  dataset_id = dremio_table.employees.id
+  column     = "ssn"
+  udf_path   = dremio_udf.mask_ssn.path
+}
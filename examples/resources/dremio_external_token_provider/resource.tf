@@ -0,0 +1,12 @@
+# =============================================================================
+# Dremio External Token Provider Resource Example
+# =============================================================================
+
+# Accept JWTs issued by Microsoft Entra ID for the analytics application
+resource "dremio_external_token_provider" "entra" {
+  name       = "entra-analytics"
+  audience   = ["api://analytics"]
+  user_claim = "upn"
+  issuer_url = "https://login.microsoftonline.com/${var.azure_tenant_id}/v2.0"
+  jwks_url   = "https://login.microsoftonline.com/${var.azure_tenant_id}/discovery/v2.0/keys"
+}
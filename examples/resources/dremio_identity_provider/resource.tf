@@ -0,0 +1,16 @@
+# =============================================================================
+# Dremio Identity Provider Resource Example
+# =============================================================================
+
+# Let users log in with Okta
+resource "dremio_identity_provider" "okta" {
+  type          = "OKTA"
+  okta_url      = "https://acme.okta.com"
+  client_id     = var.okta_client_id
+  client_secret = var.okta_client_secret
+}
+
+output "okta_redirect_url" {
+  value       = dremio_identity_provider.okta.redirect_url
+  description = "Redirect URL to register in the Okta application"
+}
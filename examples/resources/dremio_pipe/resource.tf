@@ -0,0 +1,37 @@
+# =============================================================================
+# Dremio Pipe Resource Example
+# =============================================================================
+
+# Iceberg table the pipe loads into
+resource "dremio_sql_statement" "events" {
+  create_sql  = "CREATE TABLE IF NOT EXISTS lake.raw.events (event_id VARCHAR, event_type VARCHAR, event_time TIMESTAMP)"
+  destroy_sql = "DROP TABLE IF EXISTS lake.raw.events"
+}
+
+# Load the new JSON files of s3_landing/events as SQS notifies them
+resource "dremio_pipe" "events" {
+  name             = "events_pipe"
+  table            = ["lake", "raw", "events"]
+  storage_location = "@s3_landing/events"
+  file_format      = "JSON"
+
+  dedupe_lookback_period       = 7
+  notification_provider        = "AWS_SQS"
+  notification_queue_reference = "arn:aws:sqs:us-west-2:123456789012:dremio-events"
+
+  depends_on = [dremio_sql_statement.events]
+}
+
+# CSV files with a header line, paused while the table is backfilled
+resource "dremio_pipe" "orders" {
+  name             = "orders_pipe"
+  table            = ["lake", "raw", "orders"]
+  storage_location = "@s3_landing/orders"
+  file_format      = "CSV"
+  format_options   = "EXTRACT_HEADER 'true', FIELD_DELIMITER ','"
+  running          = false
+}
+
+output "events_pipe_state" {
+  value = dremio_pipe.events.pipe_state
+}
@@ -0,0 +1,23 @@
+# =============================================================================
+# Dremio Project Resource Example
+# =============================================================================
+
+# Create a project for the finance business unit
+resource "dremio_project" "finance" {
+  name          = "finance"
+  cloud_id      = dremio_cloud.aws.id
+  project_store = "s3://acme-dremio-finance"
+
+  credentials = {
+    type                  = "IAM_ROLE"
+    role_arn              = "arn:aws:iam::123456789012:role/dremio-finance"
+    instance_profile_arn  = "arn:aws:iam::123456789012:instance-profile/dremio-finance"
+    external_id           = var.external_id
+    external_id_signature = var.external_id_signature
+  }
+}
+
+output "project_id" {
+  value       = dremio_project.finance.id
+  description = "ID of the project"
+}
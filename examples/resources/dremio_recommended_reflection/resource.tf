@@ -0,0 +1,13 @@
+# =============================================================================
+# Dremio Recommended Reflection Resource Example
+# =============================================================================
+
+# Apply a usage-based recommendation reviewed by the data platform team
+resource "dremio_recommended_reflection" "orders_by_day" {
+  recommendation_id = "9f1c2d3e-0000-0000-0000-000000000001"
+}
+
+output "orders_by_day_reflection_id" {
+  value       = dremio_recommended_reflection.orders_by_day.id
+  description = "ID of the Reflection created from the recommendation"
+}
@@ -0,0 +1,51 @@
+# =============================================================================
+# Dremio Reflection Resource Example
+# =============================================================================
+
+data "dremio_table" "trips" {
+  path = ["Samples", "samples.dremio.com", "NYC-taxi-trips"]
+}
+
+# Raw Reflection covering the most queried columns
+resource "dremio_reflection" "trips_raw" {
+  name       = "trips_raw"
+  type       = "RAW"
+  dataset_id = data.dremio_table.trips.id
+
+  display_fields = [
+    { name = "pickup_datetime" },
+    { name = "passenger_count" },
+    { name = "trip_distance_mi" },
+    { name = "total_amount" },
+  ]
+
+  partition_fields = [
+    { name = "pickup_datetime" },
+  ]
+
+  sort_fields = [
+    { name = "pickup_datetime" },
+  ]
+}
+
+# Aggregation Reflection for dashboards
+resource "dremio_reflection" "trips_agg" {
+  name       = "trips_by_day"
+  type       = "AGGREGATION"
+  dataset_id = data.dremio_table.trips.id
+
+  dimension_fields = [
+    { name = "pickup_datetime", granularity = "DATE" },
+    { name = "passenger_count" },
+  ]
+
+  measure_fields = [
+    { name = "total_amount", measure_type_list = ["SUM", "AVG"] },
+    { name = "trip_distance_mi", measure_type_list = ["SUM", "MAX"] },
+  ]
+}
+
+output "trips_raw_status" {
+  value       = dremio_reflection.trips_raw.status.combined_status
+  description = "Combined status of the raw Reflection"
+}
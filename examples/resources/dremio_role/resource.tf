@@ -0,0 +1,22 @@
+# =============================================================================
+# Dremio Role Resource Example
+# =============================================================================
+
+# Create a role
+resource "dremio_role" "analysts" {
+  name        = "analysts"
+  description = "Read access to the analytics space"
+}
+
+# Grant the role read access to a space
+resource "dremio_grants" "analytics_access" {
+  catalog_object_id = dremio_space.analytics.id
+
+  grants = [
+    {
+      id           = dremio_role.analysts.id
+      grantee_type = "ROLE"
+      privileges   = ["SELECT"]
+    }
+  ]
+}
@@ -0,0 +1,11 @@
+# =============================================================================
+# Dremio Role Membership Resource Example
+# =============================================================================
+
+# Manage all user members of the analysts role
+resource "dremio_role_membership" "analysts" {
+  role_id = dremio_role.analysts.id
+  user_ids = [
+    dremio_user.jdoe.id,
+  ]
+}
@@ -0,0 +1,19 @@
+# =============================================================================
+# Dremio Row Access Policy Resource Example
+# =============================================================================
+
+# UDF returning whether the current user can see a row
+resource "dremio_udf" "region_filter" {
+  path              = ["governance", "region_filter"]
+  is_scalar         = true
+  function_arg_list = "region VARCHAR"
+  function_body     = "SELECT is_member('admins') OR region = 'EU'"
+  return_type       = "BOOLEAN"
+}
+
+# Only show the rows the UDF accepts
+resource "dremio_row_access_policy" "orders" {
+  dataset_id = dremio_table.orders.id
+  udf_path   = dremio_udf.region_filter.path
+  columns    = ["region"]
+}
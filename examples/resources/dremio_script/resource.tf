@@ -0,0 +1,19 @@
+# =============================================================================
+# Dremio Script Resource Example
+# =============================================================================
+
+# Save a shared SQL script
+resource "dremio_script" "daily_revenue" {
+  name    = "Daily revenue"
+  context = ["analytics"]
+  content = <<-SQL
+    SELECT order_date, SUM(amount) AS revenue
+    FROM orders
+    GROUP BY order_date
+  SQL
+}
+
+output "script_id" {
+  value       = dremio_script.daily_revenue.id
+  description = "ID of the script"
+}
@@ -0,0 +1,15 @@
+# =============================================================================
+# Dremio Script Grants Resource Example
+# =============================================================================
+
+# Share the script with the analysts role
+resource "dremio_script_grants" "daily_revenue" {
+  script_id = dremio_script.daily_revenue.id
+
+  roles = [
+    {
+      grantee_id = dremio_role.analysts.id
+      privileges = ["VIEW"]
+    }
+  ]
+}
@@ -0,0 +1,27 @@
+# =============================================================================
+# Dremio Space Resource Example
+# =============================================================================
+
+# Create a space
+resource "dremio_space" "analytics" {
+  name = "analytics"
+
+  access_control_list = {
+    roles = [
+      {
+        id          = "role-uuid-here"
+        permissions = ["SELECT"]
+      }
+    ]
+  }
+}
+
+# Create a folder inside the space
+resource "dremio_folder" "analytics_staging" {
+  path = [dremio_space.analytics.name, "staging"]
+}
+
+output "space_id" {
+  value       = dremio_space.analytics.id
+  description = "ID of the space"
+}
@@ -0,0 +1,31 @@
+# =============================================================================
+# Dremio SQL Statement Resource Example
+# =============================================================================
+
+# Create a table from a query, and drop it on destroy
+resource "dremio_sql_statement" "daily_trips" {
+  create_sql  = "CREATE TABLE IF NOT EXISTS lake.analytics.daily_trips AS SELECT CAST(pickup_datetime AS DATE) AS trip_date, COUNT(*) AS trips FROM samples.nyc_trips GROUP BY 1"
+  destroy_sql = "DROP TABLE IF EXISTS lake.analytics.daily_trips"
+
+  # Recreate the table when it is dropped outside of Terraform
+  read_sql = "SELECT table_name FROM INFORMATION_SCHEMA.\"TABLES\" WHERE table_schema = 'lake.analytics' AND table_name = 'daily_trips'"
+  timeout  = "30m"
+}
+
+# Change a table property in place
+resource "dremio_sql_statement" "daily_trips_retention" {
+  create_sql  = "ALTER TABLE lake.analytics.daily_trips SET TBLPROPERTIES ('history.expire.max-snapshot-age-ms' = '604800000')"
+  update_sql  = "ALTER TABLE lake.analytics.daily_trips SET TBLPROPERTIES ('history.expire.max-snapshot-age-ms' = '604800000')"
+  destroy_sql = "ALTER TABLE lake.analytics.daily_trips UNSET TBLPROPERTIES ('history.expire.max-snapshot-age-ms')"
+
+  depends_on = [dremio_sql_statement.daily_trips]
+}
+
+# Replace a view when its definition is changed outside of Terraform
+resource "dremio_sql_statement" "active_customers" {
+  create_sql  = "CREATE OR REPLACE VIEW analytics.active_customers AS SELECT * FROM lake.crm.customers WHERE active"
+  destroy_sql = "DROP VIEW IF EXISTS analytics.active_customers"
+
+  read_sql        = "SELECT view_definition AS definition FROM INFORMATION_SCHEMA.\"VIEWS\" WHERE table_schema = 'analytics' AND table_name = 'active_customers'"
+  expected_result = jsonencode([{ definition = "SELECT * FROM lake.crm.customers WHERE active" }])
+}
@@ -0,0 +1,16 @@
+# =============================================================================
+# Dremio User Resource Example
+# =============================================================================
+
+# Invite a user to the organization (Dremio Cloud)
+resource "dremio_user" "jdoe" {
+  user_name  = "jdoe@example.com"
+  email      = "jdoe@example.com"
+  first_name = "Jane"
+  last_name  = "Doe"
+}
+
+output "user_id" {
+  value       = dremio_user.jdoe.id
+  description = "ID of the user"
+}
@@ -0,0 +1,166 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &dremioPipeLoadFiles{}
+	_ action.ActionWithConfigure = &dremioPipeLoadFiles{}
+)
+
+type dremioPipeLoadFiles struct {
+	client *dremioClient.Client
+}
+
+func NewDremioPipeLoadFilesAction() action.Action {
+	return &dremioPipeLoadFiles{}
+}
+
+// Metadata returns the action type name.
+func (a *dremioPipeLoadFiles) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipe_load_files"
+}
+
+func (a *dremioPipeLoadFiles) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_pipe_load_files"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	a.client = client
+}
+
+// Schema defines the schema for the action.
+func (a *dremioPipeLoadFiles) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Pipe Load Files action - submits files to a pipe, which loads them into its table with `COPY INTO`. " +
+			"Useful for pipes without file notifications, or to load files that were written before the pipe was created.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"pipe_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the pipe that loads the files",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"files": schema.ListNestedAttribute{
+				MarkdownDescription: "Files to load",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Path of the file, relative to the storage location of the pipe",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"size": schema.StringAttribute{
+							MarkdownDescription: "Estimated size of the file, e.g. `80 MB`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Invoke submits the files to the pipe.
+func (a *dremioPipeLoadFiles) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data models.DremioPipeLoadFilesModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var files []models.PipeFileModel
+	resp.Diagnostics.Append(data.Files.ElementsAs(ctx, &files, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.PipeLoadFilesRequest{
+		Files: make([]models.PipeFile, 0, len(files)),
+	}
+	for _, file := range files {
+		reqBody.Files = append(reqBody.Files, models.PipeFile{
+			Path: file.Path.ValueString(),
+			Size: file.Size.ValueString(),
+		})
+	}
+
+	pipeName := data.PipeName.ValueString()
+	api_resp, err := a.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", fmt.Sprintf("/pipes/%s/loadFiles", url.PathEscape(pipeName)), reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to load files with pipe %s, got error: %s", pipeName, err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return
+	}
+
+	var loadResp models.PipeLoadFilesResponse
+	if err := json.Unmarshal(resp_body, &loadResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Submitted %d file(s) to pipe %s, request ID %s", len(reqBody.Files), pipeName, loadResp.RequestID),
+	})
+
+	tflog.Trace(ctx, "invoked a pipe load files action")
+}
@@ -0,0 +1,13 @@
+package actions
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+// projectIDAttribute returns the schema of the optional project_id attribute of project-scoped actions
+func projectIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "ID of the Dremio Cloud project the action runs in. Defaults to the provider `project_id`. Not supported on Dremio Software.",
+		Optional:            true,
+	}
+}
@@ -6,38 +6,80 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 )
 
-// HostURL - Default Hashicups URL
+// HostURL - Default Dremio Cloud URL
 const HostURL string = "https://api.dremio.cloud"
 
+const (
+	// TypeCloud is the provider type for Dremio Cloud
+	TypeCloud = "cloud"
+	// TypeSoftware is the provider type for self-hosted Dremio Software clusters
+	TypeSoftware = "software"
+)
+
+// Config holds the settings used to build a Client.
+type Config struct {
+	Host                string
+	PersonalAccessToken string
+	Username            string // Dremio Software only, used with Password to log in through /apiv2/login
+	Password            string
+	ExternalToken       string // External JWT exchanged for a Dremio access token through /oauth/token
+	ExternalTokenFile   string // File holding the external JWT, read again on every exchange
+	Type                string
+	ProjectId           string
+	Retry               RetryConfig
+}
+
 // Client -
 type Client struct {
 	HostURL             string
 	HTTPClient          *http.Client
 	PersonalAccessToken string
+	Username            string
+	Password            string
+	ExternalToken       string
+	ExternalTokenFile   string
 	Type                string
 	ProjectId           string
+	Retry               RetryConfig
+
+	// mu guards accessToken and accessTokenExpiry, the short-lived token obtained from a
+	// Software login or an OAuth token exchange
+	mu                sync.Mutex
+	accessToken       string
+	accessTokenExpiry time.Time
+	// refreshMu serializes logins and token exchanges so concurrent requests share one refresh
+	refreshMu sync.Mutex
 }
 
 // NewClient -
-func NewClient(host, personalAccessToken *string, ptype *string, projectId *string) (*Client, error) {
+func NewClient(config Config) (*Client, error) {
 	c := Client{
 		HTTPClient:          &http.Client{Timeout: 30 * time.Second},
 		HostURL:             HostURL,
-		PersonalAccessToken: *personalAccessToken,
-		Type:                *ptype,
-		ProjectId:           *projectId,
+		PersonalAccessToken: config.PersonalAccessToken,
+		Username:            config.Username,
+		Password:            config.Password,
+		ExternalToken:       config.ExternalToken,
+		ExternalTokenFile:   config.ExternalTokenFile,
+		Type:                config.Type,
+		ProjectId:           config.ProjectId,
+		Retry:               config.Retry,
 	}
 
-	if host != nil {
-		c.HostURL = *host
+	if config.Host != "" {
+		c.HostURL = strings.TrimSuffix(config.Host, "/")
 	}
 
-	// If username or password not provided, return empty client
-	if host == nil || personalAccessToken == nil {
-		return &c, nil
+	// Short-lived credentials are obtained up front so configuration errors surface immediately
+	if c.usesTokenExchange() || c.usesPasswordLogin() {
+		if err := c.refreshAccessToken(""); err != nil {
+			return nil, err
+		}
 	}
 
 	err := c.testPAT()
@@ -47,48 +89,130 @@ func NewClient(host, personalAccessToken *string, ptype *string, projectId *stri
 	return &c, nil
 }
 
-func (c *Client) RequestToDremio(method, path string, body interface{}, isGlobalEndpoint ...bool) (*http.Response, error) {
-	// Default to v3 API for dremio software
-	url := fmt.Sprintf("%s/api/v3%s", c.HostURL, path)
-
-	if c.Type == "cloud" {
-		// Override to v0 API if isGlobalEndpoint is true
-		if len(isGlobalEndpoint) > 0 && isGlobalEndpoint[0] {
-			url = fmt.Sprintf("%s/v0%s", c.HostURL, path)
-		} else {
-			// Default to v0 API for dremio cloud
-			url = fmt.Sprintf("%s/v0/projects/%s%s", c.HostURL, c.ProjectId, path)
-		}
-	}
+// IsCloud reports whether the client talks to Dremio Cloud.
+func (c *Client) IsCloud() bool {
+	return c.Type == TypeCloud
+}
 
-	var reqBody io.Reader
-	if body != nil {
-		jsonData, err := json.Marshal(body)
-		if err != nil {
-			return nil, err
-		}
-		reqBody = bytes.NewBuffer(jsonData)
+// RequireCloud returns an error when a Cloud-only feature is used against Dremio Software.
+func (c *Client) RequireCloud(feature string) error {
+	if c.IsCloud() {
+		return nil
 	}
+	return fmt.Errorf("%s is only available on Dremio Cloud, but the provider is configured with type %q", feature, c.Type)
+}
 
-	req, err := http.NewRequest(method, url, reqBody)
+// RequestToDremio sends a request to the Dremio API. On Dremio Cloud, the path is relative to the
+// provider project, unless isGlobalEndpoint is true (e.g. /projects, /user, /role).
+func (c *Client) RequestToDremio(method, path string, body interface{}, isGlobalEndpoint ...bool) (*http.Response, error) {
+	global := len(isGlobalEndpoint) > 0 && isGlobalEndpoint[0]
+	url, err := c.requestURL(c.ProjectId, path, global)
 	if err != nil {
 		return nil, err
 	}
+	return c.request(method, url, body)
+}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.PersonalAccessToken))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.HTTPClient.Do(req)
+// RequestToDremioInProject sends a request to a project-scoped endpoint of the given project.
+// An empty projectID falls back to the provider project. Dremio Software has no projects, so a
+// non-empty projectID is rejected there.
+func (c *Client) RequestToDremioInProject(projectID, method, path string, body interface{}) (*http.Response, error) {
+	if projectID == "" {
+		projectID = c.ProjectId
+	} else if !c.IsCloud() {
+		return nil, fmt.Errorf("project_id is only supported on Dremio Cloud, but the provider is configured with type %q", c.Type)
+	}
+	url, err := c.requestURL(projectID, path, false)
 	if err != nil {
 		return nil, err
 	}
+	return c.request(method, url, body)
+}
+
+// requestURL builds the URL of an API path for the given project.
+func (c *Client) requestURL(projectID, path string, global bool) (string, error) {
+	if !c.IsCloud() {
+		// Dremio Software uses the v3 API
+		return fmt.Sprintf("%s/api/v3%s", c.HostURL, path), nil
+	}
+	if global {
+		return fmt.Sprintf("%s/v0%s", c.HostURL, path), nil
+	}
+	if projectID == "" {
+		return "", fmt.Errorf("no Dremio Cloud project set for %s: set project_id on the resource or on the provider", path)
+	}
+	return fmt.Sprintf("%s/v0/projects/%s%s", c.HostURL, projectID, path), nil
+}
 
-	// Check for non-2xx status codes
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		return resp, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+// request sends a request to an absolute API URL, retrying and re-authenticating as configured.
+func (c *Client) request(method, url string, body interface{}) (*http.Response, error) {
+	var jsonData []byte
+	if body != nil {
+		var err error
+		jsonData, err = json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return resp, nil
+	reauthenticated := false
+
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if jsonData != nil {
+			reqBody = bytes.NewReader(jsonData)
+		}
+
+		req, err := http.NewRequest(method, url, reqBody)
+		if err != nil {
+			return nil, err
+		}
+
+		authorization, accessToken, err := c.authorize()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", authorization)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := c.HTTPClient.Do(req)
+		canRetry := attempt < c.Retry.MaxRetries
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && accessToken != "" && !reauthenticated {
+			// The short-lived token was revoked or expired early, get a new one and replay the request once
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			if err := c.refreshAccessToken(accessToken); err != nil {
+				return nil, err
+			}
+			reauthenticated = true
+			attempt--
+			continue
+		}
+		if err != nil {
+			// Transport errors (connection reset, timeout...) are retried for idempotent requests
+			if canRetry && isRetryableRequest(method, jsonData, 0) {
+				time.Sleep(c.Retry.backoff(attempt, nil))
+				continue
+			}
+			return nil, err
+		}
+
+		if canRetry && isRetryableStatus(resp.StatusCode) && isRetryableRequest(method, jsonData, resp.StatusCode) {
+			wait := c.Retry.backoff(attempt, resp)
+			// Drain the body so the connection can be reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			time.Sleep(wait)
+			continue
+		}
+
+		// Check for non-2xx status codes
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return resp, newAPIError(resp, body)
+		}
+
+		return resp, nil
+	}
 }
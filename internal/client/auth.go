@@ -1,15 +1,141 @@
 package dremioClient
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"time"
 )
 
+// loginRequest is the body of the Dremio Software /apiv2/login endpoint
+type loginRequest struct {
+	UserName string `json:"userName"`
+	Password string `json:"password"`
+}
+
+// loginResponse is the response of the Dremio Software /apiv2/login endpoint
+type loginResponse struct {
+	Token string `json:"token"`
+}
+
+// accessTokenRefreshMargin is how long before expiry an exchanged token is renewed
+const accessTokenRefreshMargin = time.Minute
+
 func (c *Client) testPAT() error {
-	resp, _ := c.RequestToDremio("GET", "/catalog", nil)
-	body, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode != 200 {
-		return fmt.Errorf("login failed. status: %d, body: %s", resp.StatusCode, body)
+	var resp *http.Response
+	var err error
+	if c.IsCloud() && c.ProjectId == "" {
+		// Without a provider project, check the credentials against the organization-wide project list
+		resp, err = c.RequestToDremio("GET", "/projects", nil, true)
+	} else {
+		resp, err = c.RequestToDremio("GET", "/catalog", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// usesTokenExchange reports whether the client exchanges an external JWT for a Dremio access token.
+func (c *Client) usesTokenExchange() bool {
+	return c.PersonalAccessToken == "" && (c.ExternalToken != "" || c.ExternalTokenFile != "")
+}
+
+// usesPasswordLogin reports whether the client authenticates with a username and password session.
+func (c *Client) usesPasswordLogin() bool {
+	return c.Type == TypeSoftware && c.PersonalAccessToken == "" && !c.usesTokenExchange() && c.Username != ""
+}
+
+// authorize returns the Authorization header value for a request, and the short-lived access token
+// it contains (empty when a PAT is used). Exchanged tokens are renewed shortly before they expire.
+func (c *Client) authorize() (string, string, error) {
+	switch {
+	case c.usesTokenExchange():
+		token, expiry := c.currentAccessToken()
+		if token == "" || time.Now().Add(accessTokenRefreshMargin).After(expiry) {
+			if err := c.refreshAccessToken(token); err != nil {
+				return "", "", err
+			}
+			token, _ = c.currentAccessToken()
+		}
+		return fmt.Sprintf("Bearer %s", token), token, nil
+	case c.usesPasswordLogin():
+		// Software sessions use the "_dremio{token}" scheme
+		token, _ := c.currentAccessToken()
+		return "_dremio" + token, token, nil
+	}
+	return fmt.Sprintf("Bearer %s", c.PersonalAccessToken), "", nil
+}
+
+// currentAccessToken returns the cached short-lived access token and its expiry.
+func (c *Client) currentAccessToken() (string, time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.accessToken, c.accessTokenExpiry
+}
+
+// setAccessToken caches a short-lived access token. A zero expiry means the token is renewed only on 401.
+func (c *Client) setAccessToken(token string, expiry time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.accessToken = token
+	c.accessTokenExpiry = expiry
+}
+
+// refreshAccessToken obtains a new access token, replacing staleToken. When several requests find
+// the same stale token at the same time, only the first one refreshes it; the others reuse the result.
+func (c *Client) refreshAccessToken(staleToken string) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	if token, _ := c.currentAccessToken(); token != staleToken {
+		return nil
+	}
+
+	if c.usesTokenExchange() {
+		return c.exchangeToken()
+	}
+	return c.login()
+}
+
+// login opens a Dremio Software session with the configured username and password.
+func (c *Client) login() error {
+	jsonData, err := json.Marshal(loginRequest{UserName: c.Username, Password: c.Password})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/apiv2/login", c.HostURL), bytes.NewReader(jsonData))
+	if err != nil {
+		return err
 	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("login failed: unable to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("login failed: %w", newAPIError(resp, body))
+	}
+
+	var loginResp loginResponse
+	if err := json.Unmarshal(body, &loginResp); err != nil {
+		return fmt.Errorf("login failed: unable to parse response: %w", err)
+	}
+	if loginResp.Token == "" {
+		return fmt.Errorf("login failed: response did not contain a token")
+	}
+
+	c.setAccessToken(loginResp.Token, time.Time{})
 	return nil
 }
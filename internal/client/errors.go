@@ -0,0 +1,132 @@
+package dremioClient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError is returned by RequestToDremio when Dremio answers with a non-2xx status code.
+// Use errors.As to access it, or the IsNotFound/IsConflict/... helpers.
+type APIError struct {
+	StatusCode       int                 // HTTP status code of the response
+	ErrorMessage     string              // Dremio errorMessage field
+	MoreInfo         string              // Dremio moreInfo field, with additional details about the error
+	ValidationErrors map[string][]string // Field validation messages, keyed by field name
+	RequestID        string              // Request ID reported by Dremio, to share with Dremio support
+	Body             string              // Raw response body, kept for bodies that are not Dremio errors
+}
+
+// apiErrorBody is the JSON error payload returned by the Dremio REST API.
+type apiErrorBody struct {
+	ErrorMessage string `json:"errorMessage"`
+	MoreInfo     string `json:"moreInfo"`
+	RequestID    string `json:"requestId"`
+	Details      *struct {
+		ValidationErrorMessages *struct {
+			FieldErrorMessages map[string][]string `json:"fieldErrorMessages"`
+		} `json:"validationErrorMessages"`
+	} `json:"details"`
+	// Some endpoints (e.g. login) use "message" instead of "errorMessage"
+	Message string `json:"message"`
+	// The OAuth token endpoint follows RFC 6749 and reports errors in error_description
+	ErrorDescription string `json:"error_description"`
+}
+
+// newAPIError builds an APIError from a non-2xx response and its already read body.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+
+	var parsed apiErrorBody
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return apiErr
+	}
+
+	apiErr.ErrorMessage = parsed.ErrorMessage
+	if apiErr.ErrorMessage == "" {
+		apiErr.ErrorMessage = parsed.Message
+	}
+	if apiErr.ErrorMessage == "" {
+		apiErr.ErrorMessage = parsed.ErrorDescription
+	}
+	apiErr.MoreInfo = parsed.MoreInfo
+	if parsed.RequestID != "" {
+		apiErr.RequestID = parsed.RequestID
+	}
+	if parsed.Details != nil && parsed.Details.ValidationErrorMessages != nil {
+		apiErr.ValidationErrors = parsed.Details.ValidationErrorMessages.FieldErrorMessages
+	}
+
+	return apiErr
+}
+
+// Error formats the Dremio error message, falling back to the raw body when it could not be parsed.
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "API request failed with status %d", e.StatusCode)
+
+	if e.ErrorMessage == "" {
+		if e.Body != "" {
+			fmt.Fprintf(&sb, ": %s", e.Body)
+		}
+		return sb.String()
+	}
+
+	fmt.Fprintf(&sb, ": %s", e.ErrorMessage)
+	if e.MoreInfo != "" {
+		fmt.Fprintf(&sb, " (%s)", e.MoreInfo)
+	}
+
+	if len(e.ValidationErrors) > 0 {
+		fields := make([]string, 0, len(e.ValidationErrors))
+		for field := range e.ValidationErrors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			fmt.Fprintf(&sb, "\n  - %s: %s", field, strings.Join(e.ValidationErrors[field], ", "))
+		}
+	}
+
+	if e.RequestID != "" {
+		fmt.Fprintf(&sb, "\nRequest ID: %s", e.RequestID)
+	}
+
+	return sb.String()
+}
+
+// StatusCode returns the HTTP status code of an APIError, or 0 if err is not an APIError.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an APIError with status 404.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+// IsConflict reports whether err is an APIError with status 409, typically a stale tag or an existing object.
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict
+}
+
+// IsBadRequest reports whether err is an APIError with status 400.
+func IsBadRequest(err error) bool {
+	return StatusCode(err) == http.StatusBadRequest
+}
+
+// IsForbidden reports whether err is an APIError with status 403.
+func IsForbidden(err error) bool {
+	return StatusCode(err) == http.StatusForbidden
+}
@@ -0,0 +1,151 @@
+package dremioClient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+)
+
+const (
+	// jobPollInterval is the wait between two job status requests
+	jobPollInterval = time.Second
+	// jobResultsPageSize is the largest page of rows the /job/{id}/results endpoint returns
+	jobResultsPageSize = 500
+)
+
+// JobError is returned by WaitForJob when a job ends in a state other than COMPLETED.
+type JobError struct {
+	JobID        string // ID of the job
+	JobState     string // Terminal state of the job (FAILED, CANCELED, ...)
+	ErrorMessage string // Error message reported by Dremio, if any
+}
+
+func (e *JobError) Error() string {
+	if e.ErrorMessage != "" {
+		return fmt.Sprintf("job %s is %s: %s", e.JobID, e.JobState, e.ErrorMessage)
+	}
+	return fmt.Sprintf("job %s is %s", e.JobID, e.JobState)
+}
+
+// isTerminalJobState reports whether a job in this state will not change anymore.
+func isTerminalJobState(state string) bool {
+	switch state {
+	case "COMPLETED", "FAILED", "CANCELED", "CANCELLED", "INVALID_STATE":
+		return true
+	}
+	return false
+}
+
+// SubmitSQL submits a SQL query in the given project and returns the ID of the job running it.
+// An empty projectID uses the provider project.
+func (c *Client) SubmitSQL(projectID string, sqlReq models.SQLRequest) (string, error) {
+	resp, err := c.RequestToDremioInProject(projectID, "POST", "/sql", sqlReq)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var sqlResp models.SQLResponse
+	if err := json.NewDecoder(resp.Body).Decode(&sqlResp); err != nil {
+		return "", fmt.Errorf("unable to parse SQL response: %w", err)
+	}
+	if sqlResp.ID == "" {
+		return "", fmt.Errorf("dremio did not return a job ID for the SQL query")
+	}
+	return sqlResp.ID, nil
+}
+
+// GetJob returns the status of a job.
+func (c *Client) GetJob(projectID, jobID string) (*models.JobResponse, error) {
+	resp, err := c.RequestToDremioInProject(projectID, "GET", fmt.Sprintf("/job/%s", url.PathEscape(jobID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var jobResp models.JobResponse
+	if err := json.NewDecoder(resp.Body).Decode(&jobResp); err != nil {
+		return nil, fmt.Errorf("unable to parse job response: %w", err)
+	}
+	return &jobResp, nil
+}
+
+// WaitForJob polls a job until it reaches a terminal state. A job that does not complete
+// returns a *JobError. When ctx is done first, the job is canceled and ctx's error is returned.
+func (c *Client) WaitForJob(ctx context.Context, projectID, jobID string) (*models.JobResponse, error) {
+	for {
+		jobResp, err := c.GetJob(projectID, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		if isTerminalJobState(jobResp.JobState) {
+			if jobResp.JobState != "COMPLETED" {
+				return jobResp, &JobError{JobID: jobID, JobState: jobResp.JobState, ErrorMessage: jobResp.ErrorMessage}
+			}
+			return jobResp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			// Do not leave the query running on the engine, the cancellation is best effort
+			if resp, err := c.RequestToDremioInProject(projectID, "POST", fmt.Sprintf("/job/%s/cancel", url.PathEscape(jobID)), nil); err == nil {
+				resp.Body.Close()
+			}
+			return jobResp, fmt.Errorf("job %s did not complete in time, last state was %s: %w", jobID, jobResp.JobState, ctx.Err())
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
+
+// GetJobResults reads up to maxRows rows of a completed job, one page at a time.
+// Numbers are decoded as json.Number so that large integers keep their precision.
+func (c *Client) GetJobResults(projectID, jobID string, maxRows int) (*models.JobResultsResponse, error) {
+	results := &models.JobResultsResponse{Rows: []map[string]interface{}{}}
+
+	for offset := 0; ; {
+		limit := jobResultsPageSize
+		if remaining := maxRows - offset; remaining < limit {
+			limit = remaining
+		}
+		// Always read one page, even with maxRows 0, to get the schema and the row count
+		if limit <= 0 {
+			limit = 1
+		}
+
+		path := fmt.Sprintf("/job/%s/results?offset=%d&limit=%d", url.PathEscape(jobID), offset, limit)
+		resp, err := c.RequestToDremioInProject(projectID, "GET", path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page models.JobResultsResponse
+		decoder := json.NewDecoder(resp.Body)
+		decoder.UseNumber()
+		err = decoder.Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse job results: %w", err)
+		}
+
+		results.RowCount = page.RowCount
+		if page.Schema != nil {
+			results.Schema = page.Schema
+		}
+		results.Rows = append(results.Rows, page.Rows...)
+		offset += len(page.Rows)
+
+		if len(page.Rows) == 0 || offset >= page.RowCount || offset >= maxRows {
+			break
+		}
+	}
+
+	if len(results.Rows) > maxRows {
+		results.Rows = results.Rows[:maxRows]
+	}
+	return results, nil
+}
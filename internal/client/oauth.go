@@ -0,0 +1,94 @@
+package dremioClient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	jwtSubjectTokenType    = "urn:ietf:params:oauth:token-type:jwt"
+	// defaultAccessTokenLifetime is used when the token endpoint does not return expires_in
+	defaultAccessTokenLifetime = time.Hour
+)
+
+// tokenExchangeResponse is the response of the /oauth/token endpoint
+type tokenExchangeResponse struct {
+	AccessToken     string `json:"access_token"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in"`
+	IssuedTokenType string `json:"issued_token_type"`
+}
+
+// externalJWT returns the external JWT to exchange. The token file is read on every exchange because
+// CI systems (GitHub OIDC, Azure workload identity...) rotate it while the provider is running.
+func (c *Client) externalJWT() (string, error) {
+	if c.ExternalTokenFile == "" {
+		return c.ExternalToken, nil
+	}
+	content, err := os.ReadFile(c.ExternalTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to read external token file: %w", err)
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("external token file %s is empty", c.ExternalTokenFile)
+	}
+	return token, nil
+}
+
+// exchangeToken exchanges the external JWT for a Dremio access token through /oauth/token
+// (RFC 8693 token exchange), and caches it until it expires.
+func (c *Client) exchangeToken() error {
+	subjectToken, err := c.externalJWT()
+	if err != nil {
+		return fmt.Errorf("token exchange failed: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", tokenExchangeGrantType)
+	form.Set("subject_token", subjectToken)
+	form.Set("subject_token_type", jwtSubjectTokenType)
+	form.Set("scope", "dremio.all")
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", c.HostURL), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("token exchange failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("token exchange failed: unable to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("token exchange failed: %w", newAPIError(resp, body))
+	}
+
+	var tokenResp tokenExchangeResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return fmt.Errorf("token exchange failed: unable to parse response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return fmt.Errorf("token exchange failed: response did not contain an access token")
+	}
+
+	lifetime := defaultAccessTokenLifetime
+	if tokenResp.ExpiresIn > 0 {
+		lifetime = time.Duration(tokenResp.ExpiresIn) * time.Second
+	}
+	c.setAccessToken(tokenResp.AccessToken, time.Now().Add(lifetime))
+	return nil
+}
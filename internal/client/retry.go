@@ -0,0 +1,140 @@
+package dremioClient
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries attempted after the first request fails
+	DefaultMaxRetries = 4
+	// DefaultRetryMaxWait is the upper bound for a single wait between two attempts
+	DefaultRetryMaxWait = 30 * time.Second
+	// retryBaseWait is the wait before the first retry, doubled on every following attempt
+	retryBaseWait = 500 * time.Millisecond
+)
+
+// RetryConfig controls how RequestToDremio retries failed requests.
+type RetryConfig struct {
+	MaxRetries int           // Number of retries after the first attempt. 0 disables retries
+	MaxWait    time.Duration // Upper bound for a single backoff wait, including Retry-After
+}
+
+// DefaultRetryConfig returns the retry settings used when the provider does not override them.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries: DefaultMaxRetries,
+		MaxWait:    DefaultRetryMaxWait,
+	}
+}
+
+// isRetryableStatus reports whether a response status code is worth retrying.
+// 429 is returned when the rate limit is hit, 502/503/504 by the load balancers in front of Dremio.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isRetryableRequest reports whether a request can be safely sent again after a failed attempt.
+// statusCode is the status of the failed attempt, or 0 when it failed with a transport error.
+// Idempotent methods are always retryable. PUT bodies that carry an optimistic-concurrency tag
+// are only retried on 429 and 503, which are returned before the request is processed: after a
+// 502, 504 or a transport error the first attempt may have been applied, and replaying it with
+// the old tag would fail with a spurious 409 conflict. POST requests are only retried when the
+// body carries a requestId, which Dremio uses as an idempotency key (e.g. EngineRequest).
+func isRetryableRequest(method string, jsonBody []byte, statusCode int) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+	case http.MethodPut:
+		return !hasTag(jsonBody) || isRejectedStatus(statusCode)
+	case http.MethodPost:
+		return hasRequestID(jsonBody)
+	}
+	return false
+}
+
+// isRejectedStatus reports whether a response status code means the request was rejected before
+// Dremio processed it, so that replaying it cannot apply it twice.
+func isRejectedStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// hasRequestID reports whether a JSON body contains a non-empty top-level requestId.
+func hasRequestID(jsonBody []byte) bool {
+	if len(jsonBody) == 0 {
+		return false
+	}
+	var body struct {
+		RequestID string `json:"requestId"`
+	}
+	if err := json.Unmarshal(jsonBody, &body); err != nil {
+		return false
+	}
+	return body.RequestID != ""
+}
+
+// hasTag reports whether a JSON body contains a non-empty top-level tag.
+func hasTag(jsonBody []byte) bool {
+	if len(jsonBody) == 0 {
+		return false
+	}
+	var body struct {
+		Tag string `json:"tag"`
+	}
+	if err := json.Unmarshal(jsonBody, &body); err != nil {
+		return false
+	}
+	return body.Tag != ""
+}
+
+// backoff returns how long to wait before the given retry attempt (starting at 0).
+// It uses exponential backoff with jitter, and honors the Retry-After header when present.
+func (r RetryConfig) backoff(attempt int, resp *http.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		if wait > r.MaxWait {
+			return r.MaxWait
+		}
+		return wait
+	}
+
+	wait := float64(retryBaseWait) * math.Pow(2, float64(attempt))
+	if wait > float64(r.MaxWait) {
+		wait = float64(r.MaxWait)
+	}
+	// Jitter: pick a random duration between half the computed wait and the computed wait
+	half := wait / 2
+	return time.Duration(half + rand.Float64()*half)
+}
+
+// retryAfter parses the Retry-After header, which can be either a number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
@@ -48,6 +48,11 @@ func (d *dremioDataMaintenanceTaskDataSource) Configure(_ context.Context, req d
 
 		return
 	}
+	if err := client.RequireCloud("dremio_data_maintenance_task"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
 	d.client = client
 }
 
@@ -55,6 +60,7 @@ func (d *dremioDataMaintenanceTaskDataSource) Schema(_ context.Context, _ dataso
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Dremio Data Maintenance Task data source - retrieves information about an existing data maintenance task",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier (UUID) of the maintenance task",
 				Required:            true,
@@ -96,7 +102,7 @@ func (d *dremioDataMaintenanceTaskDataSource) Read(ctx context.Context, req data
 	taskID := data.ID.ValueString()
 
 	// Make API request
-	api_resp, err := d.client.RequestToDremio("GET", fmt.Sprintf("/maintenance/tasks/%s", taskID), nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/maintenance/tasks/%s", taskID), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
@@ -0,0 +1,193 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dremioDatasetLineageDataSource{}
+	_ datasource.DataSourceWithConfigure = &dremioDatasetLineageDataSource{}
+)
+
+type dremioDatasetLineageDataSource struct {
+	client *dremioClient.Client
+}
+
+func NewDremioDatasetLineageDataSource() datasource.DataSource {
+	return &dremioDatasetLineageDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *dremioDatasetLineageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_lineage"
+}
+
+func (d *dremioDatasetLineageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *dremioDatasetLineageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	datasetAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the dataset",
+			Computed:            true,
+		},
+		"path": schema.ListAttribute{
+			MarkdownDescription: "Full path of the dataset",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the dataset (PROMOTED for tables, VIRTUAL for views)",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time the dataset was created (UTC)",
+			Computed:            true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Dataset Lineage data source - retrieves the sources, parents and children of an existing dataset",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the table or view",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"sources": schema.ListNestedAttribute{
+				MarkdownDescription: "Sources the dataset reads from",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the source",
+							Computed:            true,
+						},
+						"path": schema.ListAttribute{
+							MarkdownDescription: "Path of the source",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the source, e.g. S3 or POSTGRES",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"parents": schema.ListNestedAttribute{
+				MarkdownDescription: "Datasets the dataset is built on",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: datasetAttributes,
+				},
+			},
+			"children": schema.ListNestedAttribute{
+				MarkdownDescription: "Datasets built on the dataset",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: datasetAttributes,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dremioDatasetLineageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioDatasetLineageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	datasetID := data.DatasetID.ValueString()
+
+	// Make API request
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/graph", datasetID), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read lineage of dataset %s: %s", datasetID, err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	api_resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return
+	}
+
+	var lineageResp models.LineageResponse
+	if err := json.Unmarshal(api_resp_body, &lineageResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return
+	}
+
+	// Map response to state
+	d.mapResponseToState(ctx, &lineageResp, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapResponseToState maps the API response to the Terraform state model
+func (d *dremioDatasetLineageDataSource) mapResponseToState(ctx context.Context, lineageResp *models.LineageResponse, data *models.DremioDatasetLineageDataSourceModel, diags *diag.Diagnostics) {
+	sources, convDiags := helpers.ConvertLineageSourcesToTerraform(ctx, lineageResp.Sources)
+	diags.Append(convDiags...)
+	data.Sources = sources
+
+	parents, convDiags := helpers.ConvertLineageDatasetsToTerraform(ctx, lineageResp.Parents)
+	diags.Append(convDiags...)
+	data.Parents = parents
+
+	children, convDiags := helpers.ConvertLineageDatasetsToTerraform(ctx, lineageResp.Children)
+	diags.Append(convDiags...)
+	data.Children = children
+}
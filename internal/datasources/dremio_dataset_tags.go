@@ -56,6 +56,7 @@ func (d *dremioDatasetTagsDataSource) Schema(_ context.Context, _ datasource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Dremio Dataset Tags data source - retrieves tags for an existing dataset",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"dataset_id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the dataset",
 				Required:            true,
@@ -86,7 +87,7 @@ func (d *dremioDatasetTagsDataSource) Read(ctx context.Context, req datasource.R
 	datasetID := data.DatasetID.ValueString()
 
 	// Make API request
-	api_resp, err := d.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
@@ -56,6 +56,7 @@ func (d *dremioDatasetWikiDataSource) Schema(_ context.Context, _ datasource.Sch
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Dremio Dataset Wiki data source - retrieves wiki content for an existing dataset",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"dataset_id": schema.StringAttribute{
 				MarkdownDescription: "UUID of the source, folder, or dataset",
 				Required:            true,
@@ -85,7 +86,7 @@ func (d *dremioDatasetWikiDataSource) Read(ctx context.Context, req datasource.R
 	datasetID := data.DatasetID.ValueString()
 
 	// Make API request
-	api_resp, err := d.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
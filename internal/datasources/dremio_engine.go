@@ -47,6 +47,11 @@ func (d *dremioEngineDataSource) Configure(_ context.Context, req datasource.Con
 		)
 		return
 	}
+	if err := client.RequireCloud("dremio_engine"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
 	d.client = client
 }
 
@@ -66,6 +71,7 @@ func (d *dremioEngineDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		MarkdownDescription: "Dremio Engine data source - retrieves information about an existing engine in Dremio Cloud",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the engine (UUID). Exactly one of `id` or `name` must be specified.",
 				Computed:            true,
@@ -157,7 +163,7 @@ func (d *dremioEngineDataSource) Read(ctx context.Context, req datasource.ReadRe
 		apiPath = fmt.Sprintf("/engines/%s", engineID)
 	}
 
-	api_resp, err := d.client.RequestToDremio("GET", apiPath, nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", apiPath, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
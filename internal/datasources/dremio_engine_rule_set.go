@@ -47,6 +47,11 @@ func (d *dremioEngineRuleSetDataSource) Configure(_ context.Context, req datasou
 		)
 		return
 	}
+	if err := client.RequireCloud("dremio_engine_rule_set"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
 	d.client = client
 }
 
@@ -81,6 +86,7 @@ func (d *dremioEngineRuleSetDataSource) Schema(_ context.Context, _ datasource.S
 		MarkdownDescription: "Dremio Engine Rule Set data source - retrieves engine routing rules for a Dremio project",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"rule_infos": schema.ListNestedAttribute{
 				MarkdownDescription: "List of routing rules. Rules are evaluated in order.",
 				Computed:            true,
@@ -110,7 +116,7 @@ func (d *dremioEngineRuleSetDataSource) Read(ctx context.Context, req datasource
 	}
 
 	// Make API request
-	api_resp, err := d.client.RequestToDremio("GET", "/rules", nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", "/rules", nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
@@ -60,6 +60,7 @@ func (d *dremioFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Dremio File data source - retrieves information about an existing file",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the file",
 				Computed:            true,
@@ -116,7 +117,7 @@ func (d *dremioFileDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	file_path_str := "/" + strings.Join(file_path, "/")
 	path := fmt.Sprintf("/catalog/by-path/%s", file_path_str)
 
-	api_resp, err := d.client.RequestToDremio("GET", path, nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", path, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
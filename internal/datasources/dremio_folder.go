@@ -74,6 +74,7 @@ func (d *dremioFolderDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Dremio Folder data source - retrieves information about an existing folder",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the folder. Exactly one of `id` or `path` must be specified.",
 				Computed:            true,
@@ -260,7 +261,7 @@ func (d *dremioFolderDataSource) Read(ctx context.Context, req datasource.ReadRe
 		path += fmt.Sprintf("?maxChildren=%d", data.MaxChildren.ValueInt64())
 	}
 
-	api_resp, err := d.client.RequestToDremio("GET", path, nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", path, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
@@ -57,6 +57,7 @@ func (d *dremioGrantsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Dremio Grants data source - retrieves grants (privileges) for an existing catalog object such as sources, spaces, folders, datasets, views, and UDFs.",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"catalog_object_id": schema.StringAttribute{
 				MarkdownDescription: "UUID of the Dremio catalog object to retrieve grants for.",
 				Required:            true,
@@ -104,7 +105,7 @@ func (d *dremioGrantsDataSource) Read(ctx context.Context, req datasource.ReadRe
 	catalogObjectID := data.CatalogObjectID.ValueString()
 
 	// Make API request
-	apiResp, err := d.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
+	apiResp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
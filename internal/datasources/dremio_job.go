@@ -0,0 +1,166 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dremioJobDataSource{}
+	_ datasource.DataSourceWithConfigure = &dremioJobDataSource{}
+)
+
+type dremioJobDataSource struct {
+	client *dremioClient.Client
+}
+
+func NewDremioJobDataSource() datasource.DataSource {
+	return &dremioJobDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *dremioJobDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+func (d *dremioJobDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *dremioJobDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Job data source - retrieves the status, timing and acceleration of a job",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"job_id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the job",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"job_state": schema.StringAttribute{
+				MarkdownDescription: "State of the job, e.g. RUNNING, COMPLETED, FAILED or CANCELED",
+				Computed:            true,
+			},
+			"query_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the job, e.g. UI_RUN, REST or ACCELERATOR_CREATE",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "User who ran the job",
+				Computed:            true,
+			},
+			"query_text": schema.StringAttribute{
+				MarkdownDescription: "SQL the job ran",
+				Computed:            true,
+			},
+			"row_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of rows the job returned",
+				Computed:            true,
+			},
+			"error_message": schema.StringAttribute{
+				MarkdownDescription: "Error message, if the job failed",
+				Computed:            true,
+			},
+			"started_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the job started (UTC)",
+				Computed:            true,
+			},
+			"ended_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the job ended (UTC)",
+				Computed:            true,
+			},
+			"accelerated": schema.BoolAttribute{
+				MarkdownDescription: "Whether a Reflection was chosen to accelerate the job",
+				Computed:            true,
+			},
+			"reflections": schema.ListNestedAttribute{
+				MarkdownDescription: "Reflections Dremio considered for the job",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"reflection_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the Reflection",
+							Computed:            true,
+						},
+						"dataset_id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the dataset the Reflection accelerates",
+							Computed:            true,
+						},
+						"relationship": schema.StringAttribute{
+							MarkdownDescription: "Relationship of the Reflection to the job: CONSIDERED, MATCHED or CHOSEN",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dremioJobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioJobDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobID := data.JobID.ValueString()
+	jobResp, err := d.client.GetJob(data.ProjectID.ValueString(), jobID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read job %s: %s", jobID, err),
+		)
+		return
+	}
+
+	reflections, accelerated, diags := helpers.ConvertJobReflectionsToTerraform(ctx, jobResp.Acceleration)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.JobState = types.StringValue(jobResp.JobState)
+	data.QueryType = types.StringValue(jobResp.QueryType)
+	data.User = types.StringValue(jobResp.User)
+	data.QueryText = types.StringValue(jobResp.QueryText)
+	data.RowCount = types.Int64Value(int64(jobResp.RowCount))
+	data.ErrorMessage = types.StringValue(jobResp.ErrorMessage)
+	data.StartedAt = types.StringValue(jobResp.StartedAt)
+	data.EndedAt = types.StringValue(jobResp.EndedAt)
+	data.Accelerated = types.BoolValue(accelerated)
+	data.Reflections = reflections
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,171 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ datasource.DataSource              = &dremioProjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dremioProjectsDataSource{}
+)
+
+func NewDremioProjectsDataSource() datasource.DataSource {
+	return &dremioProjectsDataSource{}
+}
+
+type dremioProjectsDataSource struct {
+	client *dremioClient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dremioProjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *dremioProjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_projects"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dremioProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Projects data source - lists the projects of the Dremio Cloud organization (Dremio Cloud only)",
+		Attributes: map[string]schema.Attribute{
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Only return projects in this state. Valid values: CREATING, ACTIVE, INACTIVE, ARCHIVED, ACTIVATING, DEACTIVATING, ARCHIVING, RESTORING.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("CREATING", "ACTIVE", "INACTIVE", "ARCHIVED", "ACTIVATING", "DEACTIVATING", "ARCHIVING", "RESTORING"),
+				},
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "Projects of the organization",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the project",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the project",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the project",
+							Computed:            true,
+						},
+						"cloud_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the cloud where the compute resources of the project are created",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State of the project",
+							Computed:            true,
+						},
+						"catalog_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the primary catalog of the project",
+							Computed:            true,
+						},
+						"catalog_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the primary catalog of the project",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Date and time the project was created (UTC)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dremioProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioProjectsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Projects are organization-wide, not project-scoped
+	api_resp, err := d.client.RequestToDremio("GET", "/projects", nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to list projects: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	api_resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return
+	}
+
+	var projectsResp []models.ProjectResponse
+	if err := json.Unmarshal(api_resp_body, &projectsResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return
+	}
+
+	projects := make([]models.ProjectResponse, 0, len(projectsResp))
+	for _, project := range projectsResp {
+		if !data.State.IsNull() && project.State != data.State.ValueString() {
+			continue
+		}
+		projects = append(projects, project)
+	}
+
+	projectList, diags := helpers.ConvertProjectsToTerraform(ctx, projects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Projects = projectList
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,356 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &dremioReflectionRecommendationsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &dremioReflectionRecommendationsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &dremioReflectionRecommendationsDataSource{}
+)
+
+func NewDremioReflectionRecommendationsDataSource() datasource.DataSource {
+	return &dremioReflectionRecommendationsDataSource{}
+}
+
+type dremioReflectionRecommendationsDataSource struct {
+	client *dremioClient.Client
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+func (d *dremioReflectionRecommendationsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("job_ids"),
+			path.MatchRoot("dataset_id"),
+		),
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *dremioReflectionRecommendationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reflection_recommendations"
+}
+
+func (d *dremioReflectionRecommendationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *dremioReflectionRecommendationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	fieldSchema := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the dataset field",
+						Computed:            true,
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Reflection Recommendations data source - retrieves the Reflections Dremio recommends for a set of jobs, a dataset, or the query history of the project. " +
+			"When neither `job_ids` nor `dataset_id` is set, the usage-based recommendations of the project are returned.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"job_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the jobs to get recommendations for (Dremio Cloud only). Conflicts with `dataset_id`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the table or view to get recommendations for. Conflicts with `job_ids`.",
+				Optional:            true,
+			},
+			"reflection_type": schema.StringAttribute{
+				MarkdownDescription: "Type of Reflection to recommend for `dataset_id`: `RAW` or `AGGREGATION`. Default is `AGGREGATION`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("RAW", "AGGREGATION"),
+					stringvalidator.AlsoRequires(path.MatchRoot("dataset_id")),
+				},
+			},
+			"recommendations": schema.ListNestedAttribute{
+				MarkdownDescription: "Recommended Reflections. The field lists use the same shape as the `dremio_reflection` resource.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"recommendation_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the usage-based recommendation, to use with `dremio_recommended_reflection`. Null for job and dataset recommendations.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Reflection type (RAW or AGGREGATION)",
+							Computed:            true,
+						},
+						"dataset_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the dataset the Reflection would be created on, when reported by Dremio",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the Reflection would be enabled",
+							Computed:            true,
+						},
+						"display_fields": fieldSchema("Fields of a raw Reflection"),
+						"dimension_fields": schema.ListNestedAttribute{
+							MarkdownDescription: "Dimension fields of an aggregation Reflection",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Name of the dataset field",
+										Computed:            true,
+									},
+									"granularity": schema.StringAttribute{
+										MarkdownDescription: "Grouping granularity (DATE or NORMAL)",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"measure_fields": schema.ListNestedAttribute{
+							MarkdownDescription: "Measure fields of an aggregation Reflection",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Name of the dataset field",
+										Computed:            true,
+									},
+									"measure_type_list": schema.ListAttribute{
+										MarkdownDescription: "Aggregations computed for the field",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+						"partition_fields": fieldSchema("Fields used to partition the Reflection data"),
+						"body": schema.StringAttribute{
+							MarkdownDescription: "Recommended Reflection as returned by Dremio, encoded as JSON",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dremioReflectionRecommendationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioReflectionRecommendationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var recommendations []types.Object
+	switch {
+	case !data.DatasetID.IsNull():
+		recommendations = d.readDatasetRecommendations(ctx, &data, &resp.Diagnostics)
+	case !data.JobIDs.IsNull():
+		recommendations = d.readJobRecommendations(ctx, &data, &resp.Diagnostics)
+	default:
+		recommendations = d.readUsageBasedRecommendations(ctx, &data, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recommendationsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: helpers.GetReflectionRecommendationAttrTypes()}, recommendations)
+	resp.Diagnostics.Append(diags...)
+	data.Recommendations = recommendationsList
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readDatasetRecommendations requests the recommended Reflections of a single dataset
+func (d *dremioReflectionRecommendationsDataSource) readDatasetRecommendations(ctx context.Context, data *models.DremioReflectionRecommendationsDataSourceModel, diags *diag.Diagnostics) []types.Object {
+	recommendationType := "agg"
+	if data.ReflectionType.ValueString() == "RAW" {
+		recommendationType = "raw"
+	}
+
+	path := fmt.Sprintf("/dataset/%s/reflection/recommendation/%s", data.DatasetID.ValueString(), recommendationType)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", path, nil)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to request Reflection recommendations for dataset %s: %s", data.DatasetID.ValueString(), err),
+		)
+		return nil
+	}
+	defer api_resp.Body.Close()
+
+	return d.parseRecommendations(ctx, api_resp.Body, diags)
+}
+
+// readJobRecommendations submits job IDs and returns the Reflections that would accelerate them
+func (d *dremioReflectionRecommendationsDataSource) readJobRecommendations(ctx context.Context, data *models.DremioReflectionRecommendationsDataSourceModel, diags *diag.Diagnostics) []types.Object {
+	if err := d.client.RequireCloud("Job-based Reflection recommendations"); err != nil {
+		diags.AddError("Unsupported Dremio Type", err.Error())
+		return nil
+	}
+
+	var reqBody models.JobBasedRecommendationsRequest
+	diags.Append(data.JobIDs.ElementsAs(ctx, &reqBody.JobIDs, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/reflection/recommendations", reqBody)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to request Reflection recommendations for jobs %s: %s", strings.Join(reqBody.JobIDs, ", "), err),
+		)
+		return nil
+	}
+	defer api_resp.Body.Close()
+
+	return d.parseRecommendations(ctx, api_resp.Body, diags)
+}
+
+// readUsageBasedRecommendations lists the recommendations Dremio computed from the query history of the project
+func (d *dremioReflectionRecommendationsDataSource) readUsageBasedRecommendations(ctx context.Context, data *models.DremioReflectionRecommendationsDataSourceModel, diags *diag.Diagnostics) []types.Object {
+	if err := d.client.RequireCloud("Usage-based Reflection recommendations"); err != nil {
+		diags.AddError("Unsupported Dremio Type", err.Error())
+		return nil
+	}
+
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", "/reflection/recommendations", nil)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to request usage-based Reflection recommendations: %s", err),
+		)
+		return nil
+	}
+	defer api_resp.Body.Close()
+
+	api_resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var usageResp models.UsageBasedRecommendationsResponse
+	if err := json.Unmarshal(api_resp_body, &usageResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+
+	recommendations := make([]types.Object, 0, len(usageResp.Data))
+	for _, usage := range usageResp.Data {
+		body, err := json.Marshal(usage.ReflectionRequestBody)
+		if err != nil {
+			diags.AddError(
+				"Parse Error",
+				fmt.Sprintf("Unable to encode recommendation %s: %s", usage.RecommendationID, err),
+			)
+			return nil
+		}
+
+		var recommendation models.ReflectionRecommendation
+		if err := json.Unmarshal(body, &recommendation); err != nil {
+			diags.AddError(
+				"Parse Error",
+				fmt.Sprintf("Unable to parse recommendation %s: %s", usage.RecommendationID, err),
+			)
+			return nil
+		}
+
+		recommendationObj, convDiags := helpers.ConvertReflectionRecommendationToTerraform(ctx, usage.RecommendationID, recommendation, string(body))
+		diags.Append(convDiags...)
+		recommendations = append(recommendations, recommendationObj)
+	}
+	return recommendations
+}
+
+// parseRecommendations decodes a list of recommended Reflections and converts it to Terraform objects
+func (d *dremioReflectionRecommendationsDataSource) parseRecommendations(ctx context.Context, body io.Reader, diags *diag.Diagnostics) []types.Object {
+	api_resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var recommendationsResp models.ReflectionRecommendationsResponse
+	if err := json.Unmarshal(api_resp_body, &recommendationsResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+
+	recommendations := make([]types.Object, 0, len(recommendationsResp.Data))
+	for _, recommendation := range recommendationsResp.Data {
+		body, err := json.Marshal(recommendation)
+		if err != nil {
+			diags.AddError(
+				"Parse Error",
+				fmt.Sprintf("Unable to encode recommendation: %s", err),
+			)
+			return nil
+		}
+
+		recommendationObj, convDiags := helpers.ConvertReflectionRecommendationToTerraform(ctx, "", recommendation, string(body))
+		diags.Append(convDiags...)
+		recommendations = append(recommendations, recommendationObj)
+	}
+	return recommendations
+}
@@ -0,0 +1,229 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &dremioReflectionsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &dremioReflectionsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &dremioReflectionsDataSource{}
+)
+
+func NewDremioReflectionsDataSource() datasource.DataSource {
+	return &dremioReflectionsDataSource{}
+}
+
+type dremioReflectionsDataSource struct {
+	client *dremioClient.Client
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+func (d *dremioReflectionsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("dataset_id"),
+			path.MatchRoot("dataset_path"),
+		),
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *dremioReflectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reflections"
+}
+
+func (d *dremioReflectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *dremioReflectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	stringAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+	int64Attribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Reflections data source - lists the Reflections of the project with their configuration and status, optionally only those of one dataset. " +
+			"Use it in checks to detect Reflections that failed or cannot accelerate queries.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the Reflections of the table or view with this ID. Conflicts with `dataset_path`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"dataset_path": schema.ListAttribute{
+				MarkdownDescription: "Only list the Reflections of the table or view with this full path. Conflicts with `dataset_id`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"reflections": schema.ListNestedAttribute{
+				MarkdownDescription: "Reflections of the project, or of the dataset when a filter is set",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":              stringAttribute("Unique identifier of the Reflection"),
+						"name":            stringAttribute("Name of the Reflection"),
+						"reflection_type": stringAttribute("Type of the Reflection (RAW or AGGREGATION)"),
+						"reflection_mode": stringAttribute("How the Reflection was created (MANUAL or AUTONOMOUS)"),
+						"dataset_id":      stringAttribute("ID of the anchor dataset of the Reflection"),
+						"dataset_type":    stringAttribute("Type of the anchor dataset (PHYSICAL_DATASET or VIRTUAL_DATASET)"),
+						"dataset_path": schema.ListAttribute{
+							MarkdownDescription: "Full path of the anchor dataset",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"created_at":               stringAttribute("Date and time the Reflection was created (UTC)"),
+						"updated_at":               stringAttribute("Date and time the Reflection was last updated (UTC)"),
+						"current_size_bytes":       int64Attribute("Size of the data of the latest refresh, in bytes"),
+						"total_size_bytes":         int64Attribute("Size of the data of all refreshes that were not pruned, in bytes"),
+						"output_records":           int64Attribute("Number of records of the latest refresh"),
+						"considered_count":         int64Attribute("Number of jobs that considered the Reflection during planning"),
+						"matched_count":            int64Attribute("Number of jobs that matched the Reflection during planning"),
+						"chosen_count":             int64Attribute("Number of jobs accelerated by the Reflection"),
+						"config_status":            stringAttribute("Status of the configuration (OK or INVALID)"),
+						"refresh_status":           stringAttribute("Status of the refreshes, e.g. SCHEDULED, RUNNING, MANUAL or GIVEN_UP"),
+						"availability_status":      stringAttribute("Whether the Reflection can accelerate queries, e.g. AVAILABLE, EXPIRED or NONE"),
+						"combined_status":          stringAttribute("Status combining the configuration, refresh and availability statuses, e.g. CAN_ACCELERATE, FAILED or CANNOT_ACCELERATE_SCHEDULED"),
+						"refresh_method":           stringAttribute("Method of the latest refresh (NONE, FULL or INCREMENTAL)"),
+						"failure_count":            int64Attribute("Number of failed refresh attempts"),
+						"last_failure_message":     stringAttribute("Error message of the last failed refresh"),
+						"last_refresh_at":          stringAttribute("Date and time the data of the Reflection was last refreshed (UTC)"),
+						"last_refresh_duration_ms": int64Attribute("Duration of the latest refresh, in milliseconds"),
+						"expires_at":               stringAttribute("Date and time the data of the Reflection expires (UTC)"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dremioReflectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioReflectionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var datasetPath []string
+	if !data.DatasetPath.IsNull() {
+		resp.Diagnostics.Append(data.DatasetPath.ElementsAs(ctx, &datasetPath, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	summaries := []models.ReflectionSummaryItem{}
+	pageToken := ""
+
+	// Follow the page tokens until every Reflection of the project is read
+	for {
+		requestPath := "/reflection-summary"
+		if pageToken != "" {
+			requestPath += "?pageToken=" + url.QueryEscape(pageToken)
+		}
+
+		api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", requestPath, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to list Reflections, got error: %s", err),
+			)
+			return
+		}
+
+		api_resp_body, err := io.ReadAll(api_resp.Body)
+		api_resp.Body.Close()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Read Error",
+				fmt.Sprintf("Unable to read response body: %s", err),
+			)
+			return
+		}
+
+		var summaryResp models.ReflectionSummaryResponse
+		if err := json.Unmarshal(api_resp_body, &summaryResp); err != nil {
+			resp.Diagnostics.AddError(
+				"Parse Error",
+				fmt.Sprintf("Unable to parse response: %s", err),
+			)
+			return
+		}
+
+		// Keep the Reflections of the requested dataset; the other pages are still read
+		for _, summary := range summaryResp.Data {
+			if !data.DatasetID.IsNull() && summary.DatasetID != data.DatasetID.ValueString() {
+				continue
+			}
+			if datasetPath != nil && !slices.Equal(summary.DatasetPath, datasetPath) {
+				continue
+			}
+			summaries = append(summaries, summary)
+		}
+
+		if summaryResp.NextPageToken == "" || len(summaryResp.Data) == 0 {
+			break
+		}
+		pageToken = summaryResp.NextPageToken
+	}
+
+	reflections, diags := helpers.ConvertReflectionSummariesToTerraform(ctx, summaries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Reflections = reflections
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
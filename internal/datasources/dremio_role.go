@@ -0,0 +1,150 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &dremioRoleDataSource{}
+	_ datasource.DataSourceWithConfigure        = &dremioRoleDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &dremioRoleDataSource{}
+)
+
+func NewDremioRoleDataSource() datasource.DataSource {
+	return &dremioRoleDataSource{}
+}
+
+type dremioRoleDataSource struct {
+	client *dremioClient.Client
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+func (d *dremioRoleDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *dremioRoleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *dremioRoleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *dremioRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Role data source - looks up an existing role by ID or name",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the role. Exactly one of `id` or `name` must be specified.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the role. Exactly one of `id` or `name` must be specified.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the role",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the role (INTERNAL, EXTERNAL or SYSTEM)",
+				Computed:            true,
+			},
+			"member_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of direct members of the role",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *dremioRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioRoleDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Roles are organization-wide on Dremio Cloud, not project-scoped
+	var path string
+	if !data.ID.IsNull() { // Read role by ID
+		path = fmt.Sprintf("/role/%s", data.ID.ValueString())
+	} else { // Lookup role by name
+		path = fmt.Sprintf("/role/by-name/%s", url.PathEscape(data.Name.ValueString()))
+	}
+
+	api_resp, err := d.client.RequestToDremio("GET", path, nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to request role: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	api_resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return
+	}
+
+	var roleResp models.RoleResponse
+	if err := json.Unmarshal(api_resp_body, &roleResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(roleResp.ID)
+	data.Name = types.StringValue(roleResp.Name)
+	data.Description = types.StringValue(roleResp.Description)
+	data.Type = types.StringValue(roleResp.Type)
+	data.MemberCount = types.Int64Value(roleResp.MemberCount)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,385 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &dremioSearchDataSource{}
+	_ datasource.DataSourceWithConfigure = &dremioSearchDataSource{}
+)
+
+const (
+	// searchDefaultMaxResults is the number of results read when max_results is not set
+	searchDefaultMaxResults = 1000
+	// searchMaxResultsCap is the largest max_results accepted
+	searchMaxResultsCap = 10000
+	// searchPageSize is the number of results requested per page
+	searchPageSize = 100
+)
+
+func NewDremioSearchDataSource() datasource.DataSource {
+	return &dremioSearchDataSource{}
+}
+
+type dremioSearchDataSource struct {
+	client *dremioClient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dremioSearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search"
+}
+
+func (d *dremioSearchDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_search"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dremioSearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ownerAttributes := func(kind string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("ID of the user or role that owns the %s", kind),
+				Computed:            true,
+			},
+			"owner_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the owner (USER or ROLE)",
+				Computed:            true,
+			},
+			"owner_name": schema.StringAttribute{
+				MarkdownDescription: "Username or role name of the owner",
+				Computed:            true,
+			},
+		}
+	}
+
+	catalogObjectAttributes := map[string]schema.Attribute{
+		"path": schema.ListAttribute{
+			MarkdownDescription: "Full path of the object",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"branch": schema.StringAttribute{
+			MarkdownDescription: "Branch of the object, for objects of versioned sources",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the object, e.g. TABLE, VIEW, FOLDER, SPACE, SOURCE or UDF",
+			Computed:            true,
+		},
+		"labels": schema.ListAttribute{
+			MarkdownDescription: "Labels (tags) of the object",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"wiki": schema.StringAttribute{
+			MarkdownDescription: "Wiki of the object, in Markdown",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time the object was created (UTC)",
+			Computed:            true,
+		},
+		"modified_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time the object was last modified (UTC)",
+			Computed:            true,
+		},
+		"columns": schema.ListAttribute{
+			MarkdownDescription: "Column names of the object, for tables and views",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"function_sql": schema.StringAttribute{
+			MarkdownDescription: "SQL definition of the object, for UDFs",
+			Computed:            true,
+		},
+	}
+	for name, attribute := range ownerAttributes("object") {
+		catalogObjectAttributes[name] = attribute
+	}
+
+	scriptAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Unique identifier of the script",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the script",
+			Computed:            true,
+		},
+		"content": schema.StringAttribute{
+			MarkdownDescription: "SQL content of the script",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time the script was created (UTC)",
+			Computed:            true,
+		},
+		"modified_at": schema.StringAttribute{
+			MarkdownDescription: "Date and time the script was last modified (UTC)",
+			Computed:            true,
+		},
+	}
+	for name, attribute := range ownerAttributes("script") {
+		scriptAttributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Search data source - searches catalog objects, jobs, scripts and Reflections of a project (Dremio Cloud only)",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Search string, matched against names, paths, labels, wikis and SQL",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "CEL expression that filters the results, e.g. `category in [\"TABLE\", \"VIEW\"]`",
+				Optional:            true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of results to read, across all pages. Defaults to `%d`, at most `%d`.", searchDefaultMaxResults, searchMaxResultsCap),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, searchMaxResultsCap),
+				},
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Whether more results were available than `max_results`",
+				Computed:            true,
+			},
+			"catalog_objects": schema.ListNestedAttribute{
+				MarkdownDescription: "Catalog objects found, such as tables, views, folders and UDFs",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: catalogObjectAttributes,
+				},
+			},
+			"jobs": schema.ListNestedAttribute{
+				MarkdownDescription: "Jobs found",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the job",
+							Computed:            true,
+						},
+						"sql": schema.StringAttribute{
+							MarkdownDescription: "SQL statement the job ran",
+							Computed:            true,
+						},
+						"job_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the job",
+							Computed:            true,
+						},
+						"job_state": schema.StringAttribute{
+							MarkdownDescription: "State of the job",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the user who ran the job",
+							Computed:            true,
+						},
+						"user_name": schema.StringAttribute{
+							MarkdownDescription: "Username of the user who ran the job",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Date and time the job started (UTC)",
+							Computed:            true,
+						},
+						"finish_time": schema.StringAttribute{
+							MarkdownDescription: "Date and time the job finished (UTC)",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "Error message, if the job failed",
+							Computed:            true,
+						},
+						"queried_datasets": schema.ListNestedAttribute{
+							MarkdownDescription: "Datasets the job queried",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"dataset_type": schema.StringAttribute{
+										MarkdownDescription: "Type of the dataset (TABLE or VIEW)",
+										Computed:            true,
+									},
+									"dataset_path": schema.ListAttribute{
+										MarkdownDescription: "Full path of the dataset",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"scripts": schema.ListNestedAttribute{
+				MarkdownDescription: "Scripts found",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scriptAttributes,
+				},
+			},
+			"reflections": schema.ListNestedAttribute{
+				MarkdownDescription: "Reflections found",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the Reflection",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the Reflection",
+							Computed:            true,
+						},
+						"dataset_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the dataset the Reflection accelerates (TABLE or VIEW)",
+							Computed:            true,
+						},
+						"dataset_path": schema.ListAttribute{
+							MarkdownDescription: "Full path of the dataset the Reflection accelerates",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"dataset_branch": schema.StringAttribute{
+							MarkdownDescription: "Branch of the dataset, for datasets of versioned sources",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Date and time the Reflection was created (UTC)",
+							Computed:            true,
+						},
+						"modified_at": schema.StringAttribute{
+							MarkdownDescription: "Date and time the Reflection was last modified (UTC)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dremioSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioSearchDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxResults := searchDefaultMaxResults
+	if !data.MaxResults.IsNull() {
+		maxResults = int(data.MaxResults.ValueInt64())
+	}
+
+	reqBody := models.SearchRequest{
+		Query:  data.Query.ValueString(),
+		Filter: data.Filter.ValueString(),
+	}
+	results := []models.SearchResultObject{}
+	truncated := false
+
+	// Follow the page tokens until the results are exhausted or max_results is reached
+	for {
+		reqBody.MaxResults = min(searchPageSize, maxResults-len(results))
+
+		api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/search", reqBody)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to search, got error: %s", err),
+			)
+			return
+		}
+
+		resp_body, err := io.ReadAll(api_resp.Body)
+		api_resp.Body.Close()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Read Error",
+				fmt.Sprintf("Unable to read response body: %s", err),
+			)
+			return
+		}
+
+		var searchResp models.SearchResponse
+		if err := json.Unmarshal(resp_body, &searchResp); err != nil {
+			resp.Diagnostics.AddError(
+				"Parse Error",
+				fmt.Sprintf("Unable to parse response: %s", err),
+			)
+			return
+		}
+
+		results = append(results, searchResp.Results...)
+		if searchResp.NextPageToken == "" || len(searchResp.Results) == 0 {
+			break
+		}
+		if len(results) >= maxResults {
+			truncated = true
+			break
+		}
+		reqBody.PageToken = searchResp.NextPageToken
+	}
+
+	if len(results) > maxResults {
+		results = results[:maxResults]
+		truncated = true
+	}
+
+	catalogObjects, jobs, scripts, reflections, diags := helpers.ConvertSearchResultsToTerraform(ctx, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.CatalogObjects = catalogObjects
+	data.Jobs = jobs
+	data.Scripts = scripts
+	data.Reflections = reflections
+	data.Truncated = types.BoolValue(truncated)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
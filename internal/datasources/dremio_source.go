@@ -72,6 +72,7 @@ func (d *dremioSourceDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		MarkdownDescription: "Dremio Source data source - retrieves information about an existing source",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the source. Exactly one of `id` or `name` must be specified.",
 				Optional:            true,
@@ -290,7 +291,7 @@ func (d *dremioSourceDataSource) Read(ctx context.Context, req datasource.ReadRe
 		path = fmt.Sprintf("/catalog/%s", sourceId)
 	}
 
-	api_resp, err := d.client.RequestToDremio("GET", path, nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", path, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
@@ -0,0 +1,313 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &dremioSpaceDataSource{}
+	_ datasource.DataSourceWithConfigure        = &dremioSpaceDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &dremioSpaceDataSource{}
+)
+
+func NewDremioSpaceDataSource() datasource.DataSource {
+	return &dremioSpaceDataSource{}
+}
+
+type dremioSpaceDataSource struct {
+	client *dremioClient.Client
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+func (d *dremioSpaceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *dremioSpaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space"
+}
+
+func (d *dremioSpaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *dremioSpaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Space data source - retrieves information about an existing space and its children",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the space. Exactly one of `id` or `name` must be specified.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the space. Exactly one of `id` or `name` must be specified.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Version tag for optimistic concurrency control",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the space was created (UTC)",
+				Computed:            true,
+			},
+			"children": schema.ListNestedAttribute{
+				MarkdownDescription: "Child entities in the space",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the child object",
+							Computed:            true,
+						},
+						"path": schema.ListAttribute{
+							MarkdownDescription: "Full path to the child object",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"tag": schema.StringAttribute{
+							MarkdownDescription: "Version tag",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Entity type (CONTAINER, DATASET or FILE)",
+							Computed:            true,
+						},
+						"container_type": schema.StringAttribute{
+							MarkdownDescription: "Container type (FOLDER or FUNCTION if type is CONTAINER)",
+							Computed:            true,
+						},
+						"dataset_type": schema.StringAttribute{
+							MarkdownDescription: "Dataset type (always VIRTUAL if type is DATASET)",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Date and time the child object was created",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"access_control_list": schema.SingleNestedAttribute{
+				MarkdownDescription: "User and role access settings",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"users": schema.ListNestedAttribute{
+						MarkdownDescription: "List of user access controls",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									MarkdownDescription: "User ID",
+									Computed:            true,
+								},
+								"permissions": schema.ListAttribute{
+									MarkdownDescription: "List of permissions",
+									Computed:            true,
+									ElementType:         types.StringType,
+								},
+							},
+						},
+					},
+					"roles": schema.ListNestedAttribute{
+						MarkdownDescription: "List of role access controls",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									MarkdownDescription: "Role ID",
+									Computed:            true,
+								},
+								"permissions": schema.ListAttribute{
+									MarkdownDescription: "List of permissions",
+									Computed:            true,
+									ElementType:         types.StringType,
+								},
+							},
+						},
+					},
+				},
+			},
+			"permissions": schema.ListAttribute{
+				MarkdownDescription: "User's permissions on the space",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "Owner information",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"owner_id": schema.StringAttribute{
+						MarkdownDescription: "Owner ID",
+						Computed:            true,
+					},
+					"owner_type": schema.StringAttribute{
+						MarkdownDescription: "Owner type (USER or ROLE)",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dremioSpaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioSpaceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var path string
+	if !data.ID.IsNull() { // Read space by ID
+		path = fmt.Sprintf("/catalog/%s", data.ID.ValueString())
+	} else { // Lookup space by name
+		path = fmt.Sprintf("/catalog/by-path/%s", url.PathEscape(data.Name.ValueString()))
+	}
+
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", path, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to request space: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	api_resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return
+	}
+
+	var spaceResp models.SpaceResponse
+	if err := json.Unmarshal(api_resp_body, &spaceResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return
+	}
+
+	if spaceResp.EntityType != "" && spaceResp.EntityType != "space" {
+		resp.Diagnostics.AddError(
+			"Unexpected Catalog Object",
+			fmt.Sprintf("Catalog object %s is a %s, not a space", spaceResp.ID, spaceResp.EntityType),
+		)
+		return
+	}
+
+	// Map response to state
+	d.mapResponseToState(ctx, &spaceResp, &data, &resp.Diagnostics)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapResponseToState maps the API response to the Terraform state model
+func (d *dremioSpaceDataSource) mapResponseToState(ctx context.Context, spaceResp *models.SpaceResponse, data *models.DremioSpaceDataSourceModel, diags *diag.Diagnostics) {
+
+	// Map basic fields
+	data.ID = types.StringValue(spaceResp.ID)
+	data.Name = types.StringValue(spaceResp.Name)
+	data.Tag = types.StringValue(spaceResp.Tag)
+
+	if spaceResp.CreatedAt != "" {
+		data.CreatedAt = types.StringValue(spaceResp.CreatedAt)
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	// Map children - use helper function
+	spaceChildAttrTypes := helpers.GetSpaceChildAttrTypes()
+	if len(spaceResp.Children) == 0 {
+		data.Children = types.ListNull(types.ObjectType{AttrTypes: spaceChildAttrTypes})
+	} else {
+		childObjects := make([]types.Object, 0, len(spaceResp.Children))
+		for _, child := range spaceResp.Children {
+			childObj, diagsTemp := helpers.ConvertSpaceChildToTerraform(ctx, child)
+			diags.Append(diagsTemp...)
+			childObjects = append(childObjects, childObj)
+		}
+
+		childrenList, diagsTemp := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: spaceChildAttrTypes}, childObjects)
+		diags.Append(diagsTemp...)
+		data.Children = childrenList
+	}
+
+	// Map access control list - use helper function
+	// Pass an unknown object as the plan parameter to force conversion
+	_, _, accessControlAttrTypes := helpers.GetACLAttrTypes()
+	if spaceResp.AccessControlList == nil {
+		data.AccessControlList = types.ObjectNull(accessControlAttrTypes)
+	} else {
+		var aclDiags diag.Diagnostics
+		data.AccessControlList, aclDiags = helpers.ConvertACLToTerraform(ctx, spaceResp.AccessControlList, types.ObjectUnknown(accessControlAttrTypes))
+		diags.Append(aclDiags...)
+	}
+	if diags.HasError() {
+		return
+	}
+
+	// Map permissions
+	if len(spaceResp.Permissions) == 0 {
+		data.Permissions = types.ListNull(types.StringType)
+	} else {
+		permsList, d := types.ListValueFrom(ctx, types.StringType, spaceResp.Permissions)
+		diags.Append(d...)
+		data.Permissions = permsList
+	}
+	if diags.HasError() {
+		return
+	}
+
+	// Map owner - use helper function
+	var ownerDiags diag.Diagnostics
+	data.Owner, ownerDiags = helpers.ConvertOwnerToTerraform(ctx, spaceResp.Owner)
+	diags.Append(ownerDiags...)
+}
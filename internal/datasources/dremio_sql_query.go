@@ -0,0 +1,228 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// sqlQueryDefaultTimeout is how long the query may run when timeout is not set
+	sqlQueryDefaultTimeout = 5 * time.Minute
+	// sqlQueryDefaultRowLimit is the number of rows read when row_limit is not set
+	sqlQueryDefaultRowLimit = 1000
+)
+
+var (
+	_ datasource.DataSource              = &dremioSQLQueryDataSource{}
+	_ datasource.DataSourceWithConfigure = &dremioSQLQueryDataSource{}
+)
+
+func NewDremioSQLQueryDataSource() datasource.DataSource {
+	return &dremioSQLQueryDataSource{}
+}
+
+type dremioSQLQueryDataSource struct {
+	client *dremioClient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dremioSQLQueryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_query"
+}
+
+func (d *dremioSQLQueryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *dremioSQLQueryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio SQL Query data source - runs a SQL query, waits for its job to complete and returns the results. " +
+			"The query runs on every plan and apply, so it should be a read-only `SELECT`.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"sql": schema.StringAttribute{
+				MarkdownDescription: "SQL query to run",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"context": schema.ListAttribute{
+				MarkdownDescription: "Path of the container the query runs in, used to resolve unqualified table names",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for the query to complete, as a Go duration string (e.g. `30s`, `10m`). The job is canceled when it expires. Defaults to `5m`.",
+				Optional:            true,
+			},
+			"row_limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of rows to read. Defaults to `1000`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the job that ran the query",
+				Computed:            true,
+			},
+			"row_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of rows the query returned, including the rows dropped by `row_limit`",
+				Computed:            true,
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Whether rows were dropped because the query returned more than `row_limit` rows",
+				Computed:            true,
+			},
+			"columns": schema.ListNestedAttribute{
+				MarkdownDescription: "Columns of the results, in order",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the column",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Data type of the column (e.g. VARCHAR, BIGINT, TIMESTAMP)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"rows": schema.ListAttribute{
+				MarkdownDescription: "Rows of the results, as maps from column name to value. All values are strings: numbers and booleans keep their JSON representation, nested values are JSON encoded and SQL `NULL` is null.",
+				Computed:            true,
+				ElementType:         types.MapType{ElemType: types.StringType},
+			},
+			"rows_json": schema.StringAttribute{
+				MarkdownDescription: "Rows of the results as a JSON array of objects, keeping the original value types. Use `jsondecode()` to read it.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *dremioSQLQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioSQLQueryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := sqlQueryDefaultTimeout
+	if !data.Timeout.IsNull() {
+		value, err := time.ParseDuration(data.Timeout.ValueString())
+		if err != nil || value <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid Timeout",
+				fmt.Sprintf("The value %q is not a valid duration. It must be a positive Go duration string such as \"30s\".", data.Timeout.ValueString()),
+			)
+			return
+		}
+		timeout = value
+	}
+
+	rowLimit := sqlQueryDefaultRowLimit
+	if !data.RowLimit.IsNull() {
+		rowLimit = int(data.RowLimit.ValueInt64())
+	}
+
+	sqlReq := models.SQLRequest{SQL: data.SQL.ValueString()}
+	if !data.Context.IsNull() {
+		resp.Diagnostics.Append(data.Context.ElementsAs(ctx, &sqlReq.Context, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	projectID := data.ProjectID.ValueString()
+	jobID, err := d.client.SubmitSQL(projectID, sqlReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to submit SQL query: %s", err),
+		)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Submitted SQL query as job %s", jobID))
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if _, err := d.client.WaitForJob(waitCtx, projectID, jobID); err != nil {
+		resp.Diagnostics.AddError(
+			"SQL Query Error",
+			fmt.Sprintf("The SQL query did not complete: %s", err),
+		)
+		return
+	}
+
+	results, err := d.client.GetJobResults(projectID, jobID, rowLimit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read the results of job %s: %s", jobID, err),
+		)
+		return
+	}
+
+	columns, diags := helpers.ConvertJobResultColumnsToTerraform(ctx, results.Schema)
+	resp.Diagnostics.Append(diags...)
+	rows, diags := helpers.ConvertJobResultRowsToTerraform(results.Rows)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rowsJSON, err := json.Marshal(results.Rows)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"JSON Conversion Error",
+			fmt.Sprintf("Unable to convert the rows to JSON: %s", err),
+		)
+		return
+	}
+
+	data.JobID = types.StringValue(jobID)
+	data.RowCount = types.Int64Value(int64(results.RowCount))
+	data.Truncated = types.BoolValue(results.RowCount > len(results.Rows))
+	data.Columns = columns
+	data.Rows = rows
+	data.RowsJSON = types.StringValue(string(rowsJSON))
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -74,6 +74,7 @@ func (d *dremioTableDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Dremio Table data source - retrieves information about an existing table/physical dataset",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the table. Exactly one of `id` or `path` must be specified.",
 				Computed:            true,
@@ -335,7 +336,7 @@ func (d *dremioTableDataSource) Read(ctx context.Context, req datasource.ReadReq
 		path = fmt.Sprintf("/catalog/by-path/%s", table_path_str)
 	}
 
-	api_resp, err := d.client.RequestToDremio("GET", path, nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", path, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
@@ -70,6 +70,7 @@ func (d *dremioUDFDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Dremio UDF data source - retrieves information about an existing user-defined function",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the UDF. Exactly one of `id` or `path` must be specified.",
 				Computed:            true,
@@ -219,7 +220,7 @@ func (d *dremioUDFDataSource) Read(ctx context.Context, req datasource.ReadReque
 		path = fmt.Sprintf("/catalog/by-path/%s", udf_path_str)
 	}
 
-	api_resp, err := d.client.RequestToDremio("GET", path, nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", path, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
@@ -0,0 +1,221 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &dremioUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &dremioUsageDataSource{}
+)
+
+// usageDefaultGroupBy is how usage is grouped when group_by is not set
+const usageDefaultGroupBy = "PROJECT"
+
+func NewDremioUsageDataSource() datasource.DataSource {
+	return &dremioUsageDataSource{}
+}
+
+type dremioUsageDataSource struct {
+	client *dremioClient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dremioUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+func (d *dremioUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_usage"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dremioUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Usage data source - retrieves the consumption of the organization in Dremio Consumption Units (DCUs) over a time range (Dremio Cloud only)",
+		Attributes: map[string]schema.Attribute{
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Start of the time range, in RFC 3339 format, e.g. `2026-09-01T00:00:00Z`",
+				Required:            true,
+			},
+			"end_time": schema.StringAttribute{
+				MarkdownDescription: "End of the time range, in RFC 3339 format, e.g. `2026-10-01T00:00:00Z`",
+				Required:            true,
+			},
+			"group_by": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How usage is reported: per project (PROJECT) or per engine (ENGINE). Defaults to `%s`.", usageDefaultGroupBy),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("PROJECT", "ENGINE"),
+				},
+			},
+			"usage": schema.ListNestedAttribute{
+				MarkdownDescription: "Usage of each project or engine, per reporting period",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the project or engine",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Whether the usage is reported for a project or an engine (PROJECT or ENGINE)",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Start of the reporting period",
+							Computed:            true,
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "End of the reporting period",
+							Computed:            true,
+						},
+						"usage": schema.Float64Attribute{
+							MarkdownDescription: "Usage over the period, in DCUs",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total_usage": schema.Float64Attribute{
+				MarkdownDescription: "Sum of the usage over the time range, in DCUs",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *dremioUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startTime := d.parseTime(data.StartTime, "start_time", resp)
+	endTime := d.parseTime(data.EndTime, "end_time", resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !endTime.After(startTime) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Invalid Time Range",
+			"end_time must be after start_time.",
+		)
+		return
+	}
+
+	groupBy := usageDefaultGroupBy
+	if !data.GroupBy.IsNull() {
+		groupBy = data.GroupBy.ValueString()
+	}
+
+	filter := fmt.Sprintf("start_time >= timestamp(%q) && end_time <= timestamp(%q)",
+		startTime.UTC().Format(time.RFC3339), endTime.UTC().Format(time.RFC3339))
+	usage := []models.UsageObject{}
+	pageToken := ""
+
+	// Usage is organization-wide, so it uses the global endpoint; follow the page tokens to read the whole range
+	for {
+		endpoint := fmt.Sprintf("/usage?groupBy=%s&filter=%s", groupBy, url.QueryEscape(filter))
+		if pageToken != "" {
+			endpoint += "&pageToken=" + url.QueryEscape(pageToken)
+		}
+
+		api_resp, err := d.client.RequestToDremio("GET", endpoint, nil, true)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read usage, got error: %s", err),
+			)
+			return
+		}
+
+		resp_body, err := io.ReadAll(api_resp.Body)
+		api_resp.Body.Close()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Read Error",
+				fmt.Sprintf("Unable to read response body: %s", err),
+			)
+			return
+		}
+
+		var usageResp models.UsageResponse
+		if err := json.Unmarshal(resp_body, &usageResp); err != nil {
+			resp.Diagnostics.AddError(
+				"Parse Error",
+				fmt.Sprintf("Unable to parse response: %s", err),
+			)
+			return
+		}
+
+		usage = append(usage, usageResp.Data...)
+		if usageResp.NextPageToken == nil || *usageResp.NextPageToken == "" || len(usageResp.Data) == 0 {
+			break
+		}
+		pageToken = *usageResp.NextPageToken
+	}
+
+	usageList, total, diags := helpers.ConvertUsageToTerraform(ctx, usage)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Usage = usageList
+	data.TotalUsage = types.Float64Value(total)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseTime parses an RFC 3339 time attribute, reporting an attribute error when it is invalid
+func (d *dremioUsageDataSource) parseTime(value types.String, attribute string, resp *datasource.ReadResponse) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Time",
+			fmt.Sprintf("%s must be an RFC 3339 date and time, e.g. 2026-09-01T00:00:00Z: %s", attribute, err),
+		)
+	}
+	return parsed
+}
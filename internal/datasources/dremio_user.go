@@ -0,0 +1,211 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &dremioUserDataSource{}
+	_ datasource.DataSourceWithConfigure        = &dremioUserDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &dremioUserDataSource{}
+)
+
+func NewDremioUserDataSource() datasource.DataSource {
+	return &dremioUserDataSource{}
+}
+
+type dremioUserDataSource struct {
+	client *dremioClient.Client
+}
+
+// ConfigValidators returns a list of functions which will all be performed during validation.
+func (d *dremioUserDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("user_name"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *dremioUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *dremioUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = client
+}
+
+func (d *dremioUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio User data source - looks up an existing user by ID, username or email",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the user. Exactly one of `id`, `user_name` or `email` must be specified.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "Username of the user. Exactly one of `id`, `user_name` or `email` must be specified.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user. Exactly one of `id`, `user_name` or `email` must be specified.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "First name of the user",
+				Computed:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Last name of the user",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is active",
+				Computed:            true,
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "Roles the user is a direct member of",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Role ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Role name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Role type (INTERNAL, EXTERNAL or SYSTEM)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dremioUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.DremioUserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Users are organization-wide on Dremio Cloud. On Dremio Cloud the username
+	// is the email address, so an email lookup goes through the by-name endpoint.
+	var path string
+	switch {
+	case !data.ID.IsNull():
+		path = fmt.Sprintf("/user/%s", data.ID.ValueString())
+	case !data.UserName.IsNull():
+		path = fmt.Sprintf("/user/by-name/%s", url.PathEscape(data.UserName.ValueString()))
+	default:
+		path = fmt.Sprintf("/user/by-name/%s", url.PathEscape(data.Email.ValueString()))
+	}
+
+	api_resp, err := d.client.RequestToDremio("GET", path, nil, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to request user: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	api_resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return
+	}
+
+	var userResp models.UserResponse
+	if err := json.Unmarshal(api_resp_body, &userResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return
+	}
+
+	// The by-name lookup matches the username, so make sure it is really the user with that email
+	if !data.Email.IsNull() && !strings.EqualFold(userResp.Email, data.Email.ValueString()) {
+		resp.Diagnostics.AddError(
+			"User Not Found",
+			fmt.Sprintf("No user with email %s was found", data.Email.ValueString()),
+		)
+		return
+	}
+
+	// Map response to state
+	d.mapResponseToState(ctx, &userResp, &data, &resp.Diagnostics)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapResponseToState maps the API response to the Terraform state model
+func (d *dremioUserDataSource) mapResponseToState(ctx context.Context, userResp *models.UserResponse, data *models.DremioUserDataSourceModel, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(userResp.ID)
+	data.Email = types.StringValue(userResp.Email)
+	data.FirstName = types.StringValue(userResp.FirstName)
+	data.LastName = types.StringValue(userResp.LastName)
+
+	// Dremio reports the username either as name or userName
+	if userResp.Name != "" {
+		data.UserName = types.StringValue(userResp.Name)
+	} else {
+		data.UserName = types.StringValue(userResp.UserName)
+	}
+
+	// Users are active unless Dremio says otherwise
+	data.Active = types.BoolValue(userResp.Active == nil || *userResp.Active)
+
+	var rolesDiags diag.Diagnostics
+	data.Roles, rolesDiags = helpers.ConvertRoleReferencesToTerraform(ctx, userResp.Roles)
+	diags.Append(rolesDiags...)
+}
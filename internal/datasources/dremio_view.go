@@ -74,6 +74,7 @@ func (d *dremioViewDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Dremio View data source - retrieves information about an existing view/virtual dataset",
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the view. Exactly one of `id` or `path` must be specified.",
 				Computed:            true,
@@ -228,7 +229,7 @@ func (d *dremioViewDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		path = fmt.Sprintf("/catalog/by-path/%s", view_path_str)
 	}
 
-	api_resp, err := d.client.RequestToDremio("GET", path, nil)
+	api_resp, err := d.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", path, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Read Error",
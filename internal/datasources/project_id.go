@@ -0,0 +1,13 @@
+package datasources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// projectIDAttribute returns the schema of the optional project_id attribute of project-scoped data sources
+func projectIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "ID of the Dremio Cloud project to read from. Defaults to the provider `project_id`. Not supported on Dremio Software.",
+		Optional:            true,
+	}
+}
@@ -0,0 +1,320 @@
+package ephemeralresources
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &dremioPersonalAccessToken{}
+	_ ephemeral.EphemeralResourceWithConfigure = &dremioPersonalAccessToken{}
+	_ ephemeral.EphemeralResourceWithClose     = &dremioPersonalAccessToken{}
+)
+
+const (
+	// personalAccessTokenPrivateKey is the private data key holding the token to revoke on close
+	personalAccessTokenPrivateKey = "token"
+	// personalAccessTokenMaxLifespan is the longest lifespan Dremio accepts for a PAT, 180 days
+	personalAccessTokenMaxLifespan = 180 * 24 * 60 * 60 * 1000
+	// personalAccessTokenLookupAttempts is how many times the token list is read to find the ID of a new token
+	personalAccessTokenLookupAttempts = 5
+	// personalAccessTokenLookupInterval is the wait between two token list reads
+	personalAccessTokenLookupInterval = 2 * time.Second
+)
+
+// personalAccessTokenPrivate identifies the token to revoke on close
+type personalAccessTokenPrivate struct {
+	UserID  string `json:"userId"`
+	TokenID string `json:"tokenId"`
+}
+
+type dremioPersonalAccessToken struct {
+	client *dremioClient.Client
+}
+
+func NewDremioPersonalAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &dremioPersonalAccessToken{}
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *dremioPersonalAccessToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_personal_access_token"
+}
+
+func (e *dremioPersonalAccessToken) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_personal_access_token"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	e.client = client
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *dremioPersonalAccessToken) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Personal Access Token ephemeral resource - creates a short-lived personal access token for the duration of a Terraform run. " +
+			"The token is never stored in the plan or the state, and it is revoked when Terraform closes the ephemeral resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user the token belongs to. The provider credentials must be allowed to create tokens for this user.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Description of the token, e.g. the name of the CI job that uses it. A random suffix is appended to make the label of every token unique.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"milliseconds_to_expire": schema.Int64Attribute{
+				MarkdownDescription: "Lifespan of the token in milliseconds, at most 180 days. The token is revoked earlier, when Terraform closes the ephemeral resource.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, personalAccessTokenMaxLifespan),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the token",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Value of the personal access token",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the token expires (UTC)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Open creates the personal access token.
+func (e *dremioPersonalAccessToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data models.DremioPersonalAccessTokenModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.UserID.ValueString()
+
+	// Dremio only returns the token value, so the ID of the new token is found by its label.
+	// A random suffix makes the label unique, so that concurrent runs with the same label never
+	// pick, and later revoke, each other's tokens.
+	suffix, err := randomLabelSuffix()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to generate a unique token label, got error: %s", err),
+		)
+		return
+	}
+	label := fmt.Sprintf("%s-%s", data.Label.ValueString(), suffix)
+
+	reqBody := &models.TokenCreateRequest{
+		Label:                label,
+		MillisecondsToExpire: data.MillisecondsToExpire.ValueInt64(),
+	}
+
+	// Tokens belong to users, which are organization-wide, so they use the global endpoint
+	api_resp, err := e.client.RequestToDremio("POST", fmt.Sprintf("/user/%s/token", userID), reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create personal access token, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return
+	}
+	token := parseTokenValue(resp_body)
+	if token == "" {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			"Dremio returned an empty personal access token",
+		)
+		return
+	}
+
+	created, err := e.findToken(ctx, userID, label)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Personal access token was created but its ID could not be found, so it cannot be revoked automatically: %s. "+
+				"Revoke the token labeled %q of user %s in the Dremio console, or it stays valid until it expires.", err, label, userID),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(created.TID)
+	data.Token = types.StringValue(token)
+	data.ExpiresAt = types.StringValue(created.ExpiresAt)
+
+	private, err := json.Marshal(personalAccessTokenPrivate{UserID: userID, TokenID: created.TID})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"JSON Conversion Error",
+			fmt.Sprintf("Unable to marshal private data: %s", err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, personalAccessTokenPrivateKey, private)...)
+
+	tflog.Trace(ctx, "opened a personal access token ephemeral resource")
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close revokes the personal access token.
+func (e *dremioPersonalAccessToken) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, personalAccessTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var private personalAccessTokenPrivate
+	if err := json.Unmarshal(privateBytes, &private); err != nil {
+		resp.Diagnostics.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse private data: %s", err),
+		)
+		return
+	}
+
+	_, err := e.client.RequestToDremio("DELETE", fmt.Sprintf("/user/%s/token/%s", private.UserID, private.TokenID), nil, true)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Personal access token %s already revoked", private.TokenID))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to revoke personal access token %s, got error: %s", private.TokenID, err),
+		)
+		return
+	}
+}
+
+// listTokens returns the personal access tokens of a user
+func (e *dremioPersonalAccessToken) listTokens(userID string) ([]models.Token, error) {
+	api_resp, err := e.client.RequestToDremio("GET", fmt.Sprintf("/user/%s/token", userID), nil, true)
+	if err != nil {
+		return nil, err
+	}
+	defer api_resp.Body.Close()
+
+	var tokenList models.TokenListResponse
+	if err := json.NewDecoder(api_resp.Body).Decode(&tokenList); err != nil {
+		return nil, fmt.Errorf("unable to parse token list: %w", err)
+	}
+	return tokenList.Data, nil
+}
+
+// findToken returns the token with the given label, reading the token list again while it is not listed yet
+func (e *dremioPersonalAccessToken) findToken(ctx context.Context, userID, label string) (*models.Token, error) {
+	var lastErr error
+	for attempt := 0; attempt < personalAccessTokenLookupAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(personalAccessTokenLookupInterval)
+		}
+
+		tokens, err := e.listTokens(userID)
+		if err != nil {
+			lastErr = err
+			tflog.Warn(ctx, fmt.Sprintf("Unable to list personal access tokens, retrying: %s", err))
+			continue
+		}
+
+		var matches []models.Token
+		for _, token := range tokens {
+			if token.Label == label {
+				matches = append(matches, token)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			lastErr = fmt.Errorf("no token labeled %q", label)
+		case 1:
+			return &matches[0], nil
+		default:
+			// The label is unique, so this is not a token of this run; do not guess which one to revoke
+			return nil, fmt.Errorf("%d tokens labeled %q", len(matches), label)
+		}
+	}
+	return nil, lastErr
+}
+
+// randomLabelSuffix returns a random hexadecimal string that makes a token label unique
+func randomLabelSuffix() (string, error) {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(suffix), nil
+}
+
+// parseTokenValue returns the token of a create response, which is either a JSON string,
+// an object with a token field, or plain text
+func parseTokenValue(body []byte) string {
+	var token string
+	if err := json.Unmarshal(body, &token); err == nil {
+		return token
+	}
+
+	var tokenObject struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(body, &tokenObject); err == nil {
+		return tokenObject.Token
+	}
+
+	return strings.TrimSpace(string(body))
+}
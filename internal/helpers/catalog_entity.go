@@ -103,3 +103,50 @@ func ConvertFolderChildToTerraform(
 	return childObj, diags
 }
 
+// GetSpaceChildAttrTypes returns the attribute type definitions for SpaceChild structures.
+func GetSpaceChildAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.StringType,
+		"path":           types.ListType{ElemType: types.StringType},
+		"tag":            types.StringType,
+		"type":           types.StringType,
+		"container_type": types.StringType,
+		"dataset_type":   types.StringType,
+		"created_at":     types.StringType,
+	}
+}
+
+// ConvertSpaceChildToTerraform converts API SpaceChild response to Terraform state.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - apiChild: The space child from the API response
+//
+// Returns:
+//   - types.Object: The converted space child as a Terraform object
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertSpaceChildToTerraform(
+	ctx context.Context,
+	apiChild models.SpaceChild,
+) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrTypes := GetSpaceChildAttrTypes()
+
+	pathList, d := types.ListValueFrom(ctx, types.StringType, apiChild.Path)
+	diags.Append(d...)
+
+	childModel := models.SpaceChildModel{
+		ID:            types.StringValue(apiChild.ID),
+		Path:          pathList,
+		Tag:           types.StringValue(apiChild.Tag),
+		Type:          types.StringValue(apiChild.Type),
+		ContainerType: types.StringValue(apiChild.ContainerType),
+		DatasetType:   types.StringValue(apiChild.DatasetType),
+		CreatedAt:     types.StringValue(apiChild.CreatedAt),
+	}
+
+	childObj, d := types.ObjectValueFrom(ctx, attrTypes, childModel)
+	diags.Append(d...)
+	return childObj, diags
+}
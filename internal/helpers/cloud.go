@@ -0,0 +1,166 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// GetCloudAWSPropsAttrTypes returns the attribute type definitions for CloudAWSProps structures.
+func GetCloudAWSPropsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"region":               types.StringType,
+		"vpc_id":               types.StringType,
+		"subnet_ids":           types.ListType{ElemType: types.StringType},
+		"role_arn":             types.StringType,
+		"instance_profile_arn": types.StringType,
+	}
+}
+
+// GetCloudAzurePropsAttrTypes returns the attribute type definitions for CloudAzureProps structures.
+func GetCloudAzurePropsAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"tenant_id":       types.StringType,
+		"subscription_id": types.StringType,
+		"resource_group":  types.StringType,
+		"region":          types.StringType,
+		"vnet_id":         types.StringType,
+		"subnet_id":       types.StringType,
+		"client_id":       types.StringType,
+	}
+}
+
+// ConvertCloudAttributesFromTerraform converts the Terraform attributes of a cloud to API request format.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - awsObj: The AWS attributes from Terraform state/plan, null for Azure clouds
+//   - azureObj: The Azure attributes from Terraform state/plan, null for AWS clouds
+//   - tagsList: The cloud tags from Terraform state/plan
+//
+// Returns:
+//   - *models.CloudAttributes: The converted attributes for API requests
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertCloudAttributesFromTerraform(
+	ctx context.Context,
+	awsObj types.Object,
+	azureObj types.Object,
+	tagsList types.List,
+) (*models.CloudAttributes, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An empty list removes the tags that are no longer configured
+	result := &models.CloudAttributes{CloudTags: []models.CloudTag{}}
+
+	if !awsObj.IsNull() && !awsObj.IsUnknown() {
+		var awsModel models.CloudAWSPropsModel
+		diags.Append(awsObj.As(ctx, &awsModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var subnetIDs []string
+		diags.Append(awsModel.SubnetIDs.ElementsAs(ctx, &subnetIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result.AWSProps = &models.CloudAWSProps{
+			Region:             awsModel.Region.ValueString(),
+			VpcID:              awsModel.VpcID.ValueString(),
+			SubnetIDs:          subnetIDs,
+			RoleArn:            awsModel.RoleArn.ValueString(),
+			InstanceProfileArn: awsModel.InstanceProfileArn.ValueString(),
+		}
+	}
+
+	if !azureObj.IsNull() && !azureObj.IsUnknown() {
+		var azureModel models.CloudAzurePropsModel
+		diags.Append(azureObj.As(ctx, &azureModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result.AzureProps = &models.CloudAzureProps{
+			TenantID:       azureModel.TenantID.ValueString(),
+			SubscriptionID: azureModel.SubscriptionID.ValueString(),
+			ResourceGroup:  azureModel.ResourceGroup.ValueString(),
+			Region:         azureModel.Region.ValueString(),
+			VnetID:         azureModel.VnetID.ValueString(),
+			SubnetID:       azureModel.SubnetID.ValueString(),
+			ClientID:       azureModel.ClientID.ValueString(),
+		}
+	}
+
+	if !tagsList.IsNull() && !tagsList.IsUnknown() {
+		for _, tagObj := range tagsList.Elements() {
+			tag, tagDiags := ConvertCloudTagFromTerraform(ctx, tagObj.(types.Object))
+			diags.Append(tagDiags...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			result.CloudTags = append(result.CloudTags, tag)
+		}
+	}
+
+	return result, diags
+}
+
+// ConvertCloudAWSPropsToTerraform converts the AWS attributes of a cloud to a Terraform object.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - props: The AWS attributes from the API response
+//
+// Returns:
+//   - types.Object: The converted object (null if props is nil)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertCloudAWSPropsToTerraform(ctx context.Context, props *models.CloudAWSProps) (types.Object, diag.Diagnostics) {
+	if props == nil {
+		return types.ObjectNull(GetCloudAWSPropsAttrTypes()), nil
+	}
+
+	var diags diag.Diagnostics
+	awsModel := models.CloudAWSPropsModel{
+		Region:             types.StringValue(props.Region),
+		VpcID:              types.StringValue(props.VpcID),
+		SubnetIDs:          stringListValue(ctx, props.SubnetIDs, &diags),
+		RoleArn:            types.StringValue(props.RoleArn),
+		InstanceProfileArn: types.StringValue(props.InstanceProfileArn),
+	}
+
+	obj, objDiags := types.ObjectValueFrom(ctx, GetCloudAWSPropsAttrTypes(), awsModel)
+	diags.Append(objDiags...)
+	return obj, diags
+}
+
+// ConvertCloudAzurePropsToTerraform converts the Azure attributes of a cloud to a Terraform object.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - props: The Azure attributes from the API response
+//
+// Returns:
+//   - types.Object: The converted object (null if props is nil)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertCloudAzurePropsToTerraform(ctx context.Context, props *models.CloudAzureProps) (types.Object, diag.Diagnostics) {
+	if props == nil {
+		return types.ObjectNull(GetCloudAzurePropsAttrTypes()), nil
+	}
+
+	azureModel := models.CloudAzurePropsModel{
+		TenantID:       types.StringValue(props.TenantID),
+		SubscriptionID: types.StringValue(props.SubscriptionID),
+		ResourceGroup:  types.StringValue(props.ResourceGroup),
+		Region:         types.StringValue(props.Region),
+		VnetID:         types.StringValue(props.VnetID),
+		SubnetID:       types.StringValue(props.SubnetID),
+		ClientID:       types.StringValue(props.ClientID),
+	}
+
+	return types.ObjectValueFrom(ctx, GetCloudAzurePropsAttrTypes(), azureModel)
+}
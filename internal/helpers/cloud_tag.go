@@ -19,7 +19,6 @@ func GetCloudTagAttrTypes() map[string]attr.Type {
 }
 
 // ConvertCloudTagFromTerraform converts Terraform CloudTag state to API request format.
-// This is a request-only structure, so there is no ToTerraform conversion.
 //
 // Parameters:
 //   - ctx: Context for the operation
@@ -49,3 +48,23 @@ func ConvertCloudTagFromTerraform(
 	return result, diags
 }
 
+// ConvertCloudTagsToTerraform converts the cloud tags of an API response to a Terraform list.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - tags: The cloud tags from the API response
+//
+// Returns:
+//   - types.List: The converted list (empty if there are no tags)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertCloudTagsToTerraform(ctx context.Context, tags []models.CloudTag) (types.List, diag.Diagnostics) {
+	tagModels := make([]models.CloudTagModel, 0, len(tags))
+	for _, tag := range tags {
+		tagModels = append(tagModels, models.CloudTagModel{
+			Key:   types.StringValue(tag.Key),
+			Value: types.StringValue(tag.Value),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetCloudTagAttrTypes()}, tagModels)
+}
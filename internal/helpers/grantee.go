@@ -21,10 +21,11 @@ func GetGranteeRequestAttrTypes() map[string]attr.Type {
 }
 
 // GetScriptGranteeAttrTypes returns the attribute type definitions for ScriptGrantee structures.
+// Uses SetType for privileges to ensure order-independent comparison.
 func GetScriptGranteeAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"grantee_id": types.StringType,
-		"privileges": types.ListType{ElemType: types.StringType},
+		"privileges": types.SetType{ElemType: types.StringType},
 	}
 }
 
@@ -68,7 +69,6 @@ func ConvertGranteeRequestFromTerraform(
 }
 
 // ConvertScriptGranteeFromTerraform converts Terraform ScriptGrantee state to API request format.
-// This is a request-only structure, so there is no ToTerraform conversion.
 //
 // Parameters:
 //   - ctx: Context for the operation
@@ -178,3 +178,79 @@ func ConvertGranteeSetFromTerraform(
 
 	return grants, diags
 }
+
+// ConvertScriptGranteesToTerraform converts API ScriptGrantee list to Terraform set.
+// This is used for the script grants resource, once for users and once for roles.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - grantees: The list of script grantees from the API response
+//
+// Returns:
+//   - types.Set: The converted grantees as a Terraform set
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertScriptGranteesToTerraform(
+	ctx context.Context,
+	grantees []models.ScriptGrantee,
+) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	granteeAttrTypes := GetScriptGranteeAttrTypes()
+
+	granteeObjects := []attr.Value{}
+	for _, grantee := range grantees {
+		privsSet, d := types.SetValueFrom(ctx, types.StringType, grantee.Privileges)
+		diags.Append(d...)
+
+		granteeObj, d := types.ObjectValue(granteeAttrTypes, map[string]attr.Value{
+			"grantee_id": types.StringValue(grantee.GranteeID),
+			"privileges": privsSet,
+		})
+		diags.Append(d...)
+		granteeObjects = append(granteeObjects, granteeObj)
+	}
+
+	granteesSet, d := types.SetValue(types.ObjectType{AttrTypes: granteeAttrTypes}, granteeObjects)
+	diags.Append(d...)
+
+	return granteesSet, diags
+}
+
+// ConvertScriptGranteeSetFromTerraform converts a Terraform set of script grantees to API request format.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - granteesSet: The grantees set from Terraform state/plan (null or unknown yields an empty list)
+//
+// Returns:
+//   - []models.ScriptGrantee: The converted grantees for API requests (never nil)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertScriptGranteeSetFromTerraform(
+	ctx context.Context,
+	granteesSet types.Set,
+) ([]models.ScriptGrantee, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	grantees := []models.ScriptGrantee{}
+	if granteesSet.IsNull() || granteesSet.IsUnknown() {
+		return grantees, diags
+	}
+
+	var granteeObjects []types.Object
+	d := granteesSet.ElementsAs(ctx, &granteeObjects, false)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, granteeObj := range granteeObjects {
+		grantee, d := ConvertScriptGranteeFromTerraform(ctx, granteeObj)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		grantees = append(grantees, grantee)
+	}
+
+	return grantees, diags
+}
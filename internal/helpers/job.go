@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetJobReflectionAttrTypes returns the attribute type definitions for JobReflection structures.
+func GetJobReflectionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"reflection_id": types.StringType,
+		"dataset_id":    types.StringType,
+		"relationship":  types.StringType,
+	}
+}
+
+// ConvertJobReflectionsToTerraform converts the Reflection relationships of a job to a Terraform list.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - acceleration: The acceleration information from the API response, nil if the job has none
+//
+// Returns:
+//   - types.List: The converted list (empty if no Reflection was considered)
+//   - bool: Whether a Reflection was chosen to accelerate the job
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertJobReflectionsToTerraform(ctx context.Context, acceleration *models.JobAcceleration) (types.List, bool, diag.Diagnostics) {
+	reflectionModels := []models.JobReflectionModel{}
+	accelerated := false
+
+	if acceleration != nil {
+		for _, relationship := range acceleration.ReflectionRelationships {
+			reflectionModels = append(reflectionModels, models.JobReflectionModel{
+				ReflectionID: types.StringValue(relationship.ReflectionID),
+				DatasetID:    types.StringValue(relationship.DatasetID),
+				Relationship: types.StringValue(relationship.Relationship),
+			})
+			if relationship.Relationship == "CHOSEN" {
+				accelerated = true
+			}
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetJobReflectionAttrTypes()}, reflectionModels)
+	return list, accelerated, diags
+}
@@ -0,0 +1,98 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetJobResultColumnAttrTypes returns the attribute type definitions for JobResultColumn structures.
+func GetJobResultColumnAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+		"type": types.StringType,
+	}
+}
+
+// ConvertJobResultColumnsToTerraform converts the schema of job results to a Terraform list.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - fields: The schema fields from the job results
+//
+// Returns:
+//   - types.List: The converted columns as a Terraform list
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertJobResultColumnsToTerraform(
+	ctx context.Context,
+	fields []models.JobResultSchemaField,
+) (types.List, diag.Diagnostics) {
+	columns := make([]models.JobResultColumnModel, 0, len(fields))
+	for _, field := range fields {
+		columns = append(columns, models.JobResultColumnModel{
+			Name: types.StringValue(field.Name),
+			Type: types.StringValue(field.Type.Name),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetJobResultColumnAttrTypes()}, columns)
+}
+
+// ConvertJobResultRowsToTerraform converts job result rows to a Terraform list of string maps.
+// Terraform maps hold a single element type, so every value is converted to a string: numbers
+// and booleans use their JSON representation, nested values are JSON encoded, and SQL NULL
+// becomes a null element.
+//
+// Parameters:
+//   - rows: The rows from the job results, decoded with json.Number for numbers
+//
+// Returns:
+//   - types.List: The converted rows as a Terraform list of maps
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertJobResultRowsToTerraform(
+	rows []map[string]interface{},
+) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rowType := types.MapType{ElemType: types.StringType}
+	rowValues := make([]attr.Value, 0, len(rows))
+	for _, row := range rows {
+		elements := make(map[string]attr.Value, len(row))
+		for column, value := range row {
+			switch v := value.(type) {
+			case nil:
+				elements[column] = types.StringNull()
+			case string:
+				elements[column] = types.StringValue(v)
+			case json.Number:
+				elements[column] = types.StringValue(v.String())
+			case bool:
+				elements[column] = types.StringValue(strconv.FormatBool(v))
+			default:
+				encoded, err := json.Marshal(v)
+				if err != nil {
+					diags.AddError(
+						"JSON Conversion Error",
+						fmt.Sprintf("Unable to convert the value of column %q to JSON: %s", column, err),
+					)
+					return types.ListNull(rowType), diags
+				}
+				elements[column] = types.StringValue(string(encoded))
+			}
+		}
+
+		rowValue, d := types.MapValue(types.StringType, elements)
+		diags.Append(d...)
+		rowValues = append(rowValues, rowValue)
+	}
+
+	rowList, d := types.ListValue(rowType, rowValues)
+	diags.Append(d...)
+	return rowList, diags
+}
@@ -0,0 +1,86 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetLineageSourceAttrTypes returns the attribute type definitions for LineageSource structures.
+func GetLineageSourceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"path": types.ListType{ElemType: types.StringType},
+		"type": types.StringType,
+	}
+}
+
+// GetLineageDatasetAttrTypes returns the attribute type definitions for LineageDataset structures.
+func GetLineageDatasetAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"path":       types.ListType{ElemType: types.StringType},
+		"type":       types.StringType,
+		"created_at": types.StringType,
+	}
+}
+
+// ConvertLineageSourcesToTerraform converts the sources of a lineage response to a Terraform list.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - sources: The lineage sources from the API response
+//
+// Returns:
+//   - types.List: The converted list (empty if there are no sources)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertLineageSourcesToTerraform(ctx context.Context, sources []models.LineageSource) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sourceModels := make([]models.LineageSourceModel, 0, len(sources))
+	for _, source := range sources {
+		sourceModels = append(sourceModels, models.LineageSourceModel{
+			ID:   types.StringValue(source.ID),
+			Path: stringListValue(ctx, source.Path, &diags),
+			Type: types.StringValue(source.Type),
+		})
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetLineageSourceAttrTypes()}, sourceModels)
+	diags.Append(listDiags...)
+	return list, diags
+}
+
+// ConvertLineageDatasetsToTerraform converts the parents or children of a lineage response to a Terraform list.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - datasets: The lineage datasets from the API response
+//
+// Returns:
+//   - types.List: The converted list (empty if there are no datasets)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertLineageDatasetsToTerraform(ctx context.Context, datasets []models.LineageDataset) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	datasetModels := make([]models.LineageDatasetModel, 0, len(datasets))
+	for _, dataset := range datasets {
+		createdAt := types.StringNull()
+		if dataset.CreatedAt != "" {
+			createdAt = types.StringValue(dataset.CreatedAt)
+		}
+		datasetModels = append(datasetModels, models.LineageDatasetModel{
+			ID:        types.StringValue(dataset.ID),
+			Path:      stringListValue(ctx, dataset.Path, &diags),
+			Type:      types.StringValue(dataset.Type),
+			CreatedAt: createdAt,
+		})
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetLineageDatasetAttrTypes()}, datasetModels)
+	diags.Append(listDiags...)
+	return list, diags
+}
@@ -0,0 +1,56 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetProjectSummaryAttrTypes returns the attribute type definitions for ProjectSummary structures.
+func GetProjectSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"name":         types.StringType,
+		"type":         types.StringType,
+		"cloud_id":     types.StringType,
+		"state":        types.StringType,
+		"catalog_id":   types.StringType,
+		"catalog_name": types.StringType,
+		"created_at":   types.StringType,
+	}
+}
+
+// ConvertProjectsToTerraform converts API projects to a Terraform list.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - projects: The projects from the API response
+//
+// Returns:
+//   - types.List: The converted projects as a Terraform list (empty if there are no projects)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertProjectsToTerraform(
+	ctx context.Context,
+	projects []models.ProjectResponse,
+) (types.List, diag.Diagnostics) {
+	attrTypes := GetProjectSummaryAttrTypes()
+
+	projectModels := make([]models.ProjectSummaryModel, 0, len(projects))
+	for _, project := range projects {
+		projectModels = append(projectModels, models.ProjectSummaryModel{
+			ID:          types.StringValue(project.ID),
+			Name:        types.StringValue(project.Name),
+			Type:        types.StringValue(project.Type),
+			CloudID:     types.StringValue(project.CloudID),
+			State:       types.StringValue(project.State),
+			CatalogID:   types.StringValue(project.CatalogID),
+			CatalogName: types.StringValue(project.CatalogName),
+			CreatedAt:   types.StringValue(project.CreatedAt),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: attrTypes}, projectModels)
+}
@@ -0,0 +1,393 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetReflectionFieldAttrTypes returns the attribute type definitions for Reflection fields referenced by name
+// (display, distribution, partition and sort fields).
+func GetReflectionFieldAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+	}
+}
+
+// GetReflectionDimensionFieldAttrTypes returns the attribute type definitions for Reflection dimension fields.
+func GetReflectionDimensionFieldAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"granularity": types.StringType,
+	}
+}
+
+// GetReflectionMeasureFieldAttrTypes returns the attribute type definitions for Reflection measure fields.
+func GetReflectionMeasureFieldAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":              types.StringType,
+		"measure_type_list": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// GetReflectionStatusAttrTypes returns the attribute type definitions for ReflectionStatus structures.
+func GetReflectionStatusAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"config":          types.StringType,
+		"refresh":         types.StringType,
+		"availability":    types.StringType,
+		"combined_status": types.StringType,
+		"failure_count":   types.Int64Type,
+		"last_data_fetch": types.StringType,
+		"expires_at":      types.StringType,
+	}
+}
+
+// ConvertReflectionFieldNamesFromTerraform converts a Terraform list of Reflection fields referenced by name
+// to the list of field names.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - fieldsList: The list of fields from Terraform state/plan
+//
+// Returns:
+//   - []string: The field names (nil if input is null/unknown)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertReflectionFieldNamesFromTerraform(
+	ctx context.Context,
+	fieldsList types.List,
+) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if fieldsList.IsNull() || fieldsList.IsUnknown() {
+		return nil, diags
+	}
+
+	var fieldModels []models.ReflectionFieldModel
+	diags.Append(fieldsList.ElementsAs(ctx, &fieldModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(fieldModels))
+	for _, field := range fieldModels {
+		names = append(names, field.Name.ValueString())
+	}
+	return names, diags
+}
+
+// ConvertReflectionFieldNamesToTerraform converts a list of field names to a Terraform list of Reflection fields.
+// An empty API list is kept as an empty list when the prior value was an empty list, to avoid drift.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - names: The field names from the API response
+//   - priorList: The current value in Terraform state/plan
+//
+// Returns:
+//   - types.List: The converted fields for Terraform state
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertReflectionFieldNamesToTerraform(
+	ctx context.Context,
+	names []string,
+	priorList types.List,
+) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elemType := types.ObjectType{AttrTypes: GetReflectionFieldAttrTypes()}
+	if len(names) == 0 {
+		return emptyOrNullList(elemType, priorList), diags
+	}
+
+	fieldModels := make([]models.ReflectionFieldModel, 0, len(names))
+	for _, name := range names {
+		fieldModels = append(fieldModels, models.ReflectionFieldModel{Name: types.StringValue(name)})
+	}
+
+	fieldsList, d := types.ListValueFrom(ctx, elemType, fieldModels)
+	diags.Append(d...)
+	return fieldsList, diags
+}
+
+// ConvertReflectionDimensionFieldsFromTerraform converts Terraform dimension fields to API request format.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - fieldsList: The list of dimension fields from Terraform state/plan
+//
+// Returns:
+//   - []models.ReflectionDimensionField: The converted dimension fields (nil if input is null/unknown)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertReflectionDimensionFieldsFromTerraform(
+	ctx context.Context,
+	fieldsList types.List,
+) ([]models.ReflectionDimensionField, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if fieldsList.IsNull() || fieldsList.IsUnknown() {
+		return nil, diags
+	}
+
+	var fieldModels []models.ReflectionDimensionFieldModel
+	diags.Append(fieldsList.ElementsAs(ctx, &fieldModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	fields := make([]models.ReflectionDimensionField, 0, len(fieldModels))
+	for _, field := range fieldModels {
+		dimension := models.ReflectionDimensionField{Name: field.Name.ValueString()}
+		if !field.Granularity.IsNull() && !field.Granularity.IsUnknown() {
+			dimension.Granularity = field.Granularity.ValueString()
+		}
+		fields = append(fields, dimension)
+	}
+	return fields, diags
+}
+
+// ConvertReflectionDimensionFieldsToTerraform converts API dimension fields to Terraform state.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - apiFields: The dimension fields from the API response
+//   - priorList: The current value in Terraform state/plan
+//
+// Returns:
+//   - types.List: The converted dimension fields for Terraform state
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertReflectionDimensionFieldsToTerraform(
+	ctx context.Context,
+	apiFields []models.ReflectionDimensionField,
+	priorList types.List,
+) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elemType := types.ObjectType{AttrTypes: GetReflectionDimensionFieldAttrTypes()}
+	if len(apiFields) == 0 {
+		return emptyOrNullList(elemType, priorList), diags
+	}
+
+	fieldModels := make([]models.ReflectionDimensionFieldModel, 0, len(apiFields))
+	for _, field := range apiFields {
+		granularity := types.StringNull()
+		if field.Granularity != "" {
+			granularity = types.StringValue(field.Granularity)
+		}
+		fieldModels = append(fieldModels, models.ReflectionDimensionFieldModel{
+			Name:        types.StringValue(field.Name),
+			Granularity: granularity,
+		})
+	}
+
+	fieldsList, d := types.ListValueFrom(ctx, elemType, fieldModels)
+	diags.Append(d...)
+	return fieldsList, diags
+}
+
+// ConvertReflectionMeasureFieldsFromTerraform converts Terraform measure fields to API request format.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - fieldsList: The list of measure fields from Terraform state/plan
+//
+// Returns:
+//   - []models.ReflectionMeasureField: The converted measure fields (nil if input is null/unknown)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertReflectionMeasureFieldsFromTerraform(
+	ctx context.Context,
+	fieldsList types.List,
+) ([]models.ReflectionMeasureField, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if fieldsList.IsNull() || fieldsList.IsUnknown() {
+		return nil, diags
+	}
+
+	var fieldModels []models.ReflectionMeasureFieldModel
+	diags.Append(fieldsList.ElementsAs(ctx, &fieldModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	fields := make([]models.ReflectionMeasureField, 0, len(fieldModels))
+	for _, field := range fieldModels {
+		measure := models.ReflectionMeasureField{Name: field.Name.ValueString()}
+		if !field.MeasureTypeList.IsNull() && !field.MeasureTypeList.IsUnknown() {
+			diags.Append(field.MeasureTypeList.ElementsAs(ctx, &measure.MeasureTypeList, false)...)
+		}
+		fields = append(fields, measure)
+	}
+	return fields, diags
+}
+
+// ConvertReflectionMeasureFieldsToTerraform converts API measure fields to Terraform state.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - apiFields: The measure fields from the API response
+//   - priorList: The current value in Terraform state/plan
+//
+// Returns:
+//   - types.List: The converted measure fields for Terraform state
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertReflectionMeasureFieldsToTerraform(
+	ctx context.Context,
+	apiFields []models.ReflectionMeasureField,
+	priorList types.List,
+) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elemType := types.ObjectType{AttrTypes: GetReflectionMeasureFieldAttrTypes()}
+	if len(apiFields) == 0 {
+		return emptyOrNullList(elemType, priorList), diags
+	}
+
+	fieldModels := make([]models.ReflectionMeasureFieldModel, 0, len(apiFields))
+	for _, field := range apiFields {
+		measureTypes := types.ListNull(types.StringType)
+		if len(field.MeasureTypeList) > 0 {
+			var d diag.Diagnostics
+			measureTypes, d = types.ListValueFrom(ctx, types.StringType, field.MeasureTypeList)
+			diags.Append(d...)
+		}
+		fieldModels = append(fieldModels, models.ReflectionMeasureFieldModel{
+			Name:            types.StringValue(field.Name),
+			MeasureTypeList: measureTypes,
+		})
+	}
+
+	fieldsList, d := types.ListValueFrom(ctx, elemType, fieldModels)
+	diags.Append(d...)
+	return fieldsList, diags
+}
+
+// ConvertReflectionStatusToTerraform converts API ReflectionStatus response to Terraform state.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - apiStatus: The Reflection status from the API response
+//
+// Returns:
+//   - types.Object: The converted status as a Terraform object (null if the API did not return one)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertReflectionStatusToTerraform(
+	ctx context.Context,
+	apiStatus *models.ReflectionStatus,
+) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrTypes := GetReflectionStatusAttrTypes()
+	if apiStatus == nil {
+		return types.ObjectNull(attrTypes), diags
+	}
+
+	statusModel := models.ReflectionStatusModel{
+		Config:         types.StringValue(apiStatus.Config),
+		Refresh:        types.StringValue(apiStatus.Refresh),
+		Availability:   types.StringValue(apiStatus.Availability),
+		CombinedStatus: types.StringValue(apiStatus.CombinedStatus),
+		FailureCount:   types.Int64Value(int64(apiStatus.FailureCount)),
+		LastDataFetch:  types.StringValue(apiStatus.LastDataFetch),
+		ExpiresAt:      types.StringValue(apiStatus.ExpiresAt),
+	}
+
+	statusObj, d := types.ObjectValueFrom(ctx, attrTypes, statusModel)
+	diags.Append(d...)
+	return statusObj, diags
+}
+
+// GetReflectionRecommendationAttrTypes returns the attribute type definitions for ReflectionRecommendation structures.
+func GetReflectionRecommendationAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"recommendation_id": types.StringType,
+		"type":              types.StringType,
+		"dataset_id":        types.StringType,
+		"enabled":           types.BoolType,
+		"display_fields":    types.ListType{ElemType: types.ObjectType{AttrTypes: GetReflectionFieldAttrTypes()}},
+		"dimension_fields":  types.ListType{ElemType: types.ObjectType{AttrTypes: GetReflectionDimensionFieldAttrTypes()}},
+		"measure_fields":    types.ListType{ElemType: types.ObjectType{AttrTypes: GetReflectionMeasureFieldAttrTypes()}},
+		"partition_fields":  types.ListType{ElemType: types.ObjectType{AttrTypes: GetReflectionFieldAttrTypes()}},
+		"body":              types.StringType,
+	}
+}
+
+// ConvertReflectionRecommendationToTerraform converts an API Reflection recommendation to Terraform state.
+// The fields use the same shape as the dremio_reflection resource, so they can be passed to it directly.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - recommendationID: ID of the usage-based recommendation (empty for job and dataset recommendations)
+//   - apiRecommendation: The recommendation from the API response
+//   - body: The recommended Reflection as JSON
+//
+// Returns:
+//   - types.Object: The converted recommendation as a Terraform object
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertReflectionRecommendationToTerraform(
+	ctx context.Context,
+	recommendationID string,
+	apiRecommendation models.ReflectionRecommendation,
+	body string,
+) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	recommendationModel := models.ReflectionRecommendationModel{
+		RecommendationID: types.StringNull(),
+		Type:             types.StringValue(apiRecommendation.Type),
+		DatasetID:        types.StringNull(),
+		Enabled:          types.BoolValue(apiRecommendation.Enabled),
+		Body:             types.StringValue(body),
+	}
+	if recommendationID != "" {
+		recommendationModel.RecommendationID = types.StringValue(recommendationID)
+	}
+	if apiRecommendation.DatasetID != "" {
+		recommendationModel.DatasetID = types.StringValue(apiRecommendation.DatasetID)
+	}
+
+	fieldElemType := types.ObjectType{AttrTypes: GetReflectionFieldAttrTypes()}
+	var d diag.Diagnostics
+
+	displayNames := make([]string, 0, len(apiRecommendation.DisplayFields))
+	for _, field := range apiRecommendation.DisplayFields {
+		displayNames = append(displayNames, field.Name)
+	}
+	recommendationModel.DisplayFields, d = ConvertReflectionFieldNamesToTerraform(ctx, displayNames, types.ListNull(fieldElemType))
+	diags.Append(d...)
+
+	partitionNames := make([]string, 0, len(apiRecommendation.PartitionFields))
+	for _, field := range apiRecommendation.PartitionFields {
+		partitionNames = append(partitionNames, field.Name)
+	}
+	recommendationModel.PartitionFields, d = ConvertReflectionFieldNamesToTerraform(ctx, partitionNames, types.ListNull(fieldElemType))
+	diags.Append(d...)
+
+	dimensions := make([]models.ReflectionDimensionField, 0, len(apiRecommendation.DimensionFields))
+	for _, field := range apiRecommendation.DimensionFields {
+		dimensions = append(dimensions, models.ReflectionDimensionField(field))
+	}
+	recommendationModel.DimensionFields, d = ConvertReflectionDimensionFieldsToTerraform(ctx, dimensions, types.ListNull(types.ObjectType{AttrTypes: GetReflectionDimensionFieldAttrTypes()}))
+	diags.Append(d...)
+
+	measures := make([]models.ReflectionMeasureField, 0, len(apiRecommendation.MeasureFields))
+	for _, field := range apiRecommendation.MeasureFields {
+		measures = append(measures, models.ReflectionMeasureField(field))
+	}
+	recommendationModel.MeasureFields, d = ConvertReflectionMeasureFieldsToTerraform(ctx, measures, types.ListNull(types.ObjectType{AttrTypes: GetReflectionMeasureFieldAttrTypes()}))
+	diags.Append(d...)
+
+	recommendationObj, d := types.ObjectValueFrom(ctx, GetReflectionRecommendationAttrTypes(), recommendationModel)
+	diags.Append(d...)
+	return recommendationObj, diags
+}
+
+// emptyOrNullList returns an empty list when the prior value was a known empty list, and a null list otherwise.
+func emptyOrNullList(elemType attr.Type, priorList types.List) types.List {
+	if !priorList.IsNull() && !priorList.IsUnknown() {
+		return types.ListValueMust(elemType, []attr.Value{})
+	}
+	return types.ListNull(elemType)
+}
@@ -0,0 +1,93 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetReflectionSummaryAttrTypes returns the attribute type definitions for ReflectionSummary structures.
+func GetReflectionSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                       types.StringType,
+		"name":                     types.StringType,
+		"reflection_type":          types.StringType,
+		"reflection_mode":          types.StringType,
+		"dataset_id":               types.StringType,
+		"dataset_type":             types.StringType,
+		"dataset_path":             types.ListType{ElemType: types.StringType},
+		"created_at":               types.StringType,
+		"updated_at":               types.StringType,
+		"current_size_bytes":       types.Int64Type,
+		"total_size_bytes":         types.Int64Type,
+		"output_records":           types.Int64Type,
+		"considered_count":         types.Int64Type,
+		"matched_count":            types.Int64Type,
+		"chosen_count":             types.Int64Type,
+		"config_status":            types.StringType,
+		"refresh_status":           types.StringType,
+		"availability_status":      types.StringType,
+		"combined_status":          types.StringType,
+		"refresh_method":           types.StringType,
+		"failure_count":            types.Int64Type,
+		"last_failure_message":     types.StringType,
+		"last_refresh_at":          types.StringType,
+		"last_refresh_duration_ms": types.Int64Type,
+		"expires_at":               types.StringType,
+	}
+}
+
+// ConvertReflectionSummariesToTerraform converts Reflection summaries to a Terraform list.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - summaries: The Reflection summaries from the API responses
+//
+// Returns:
+//   - types.List: The converted list (empty if there are no Reflections)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertReflectionSummariesToTerraform(ctx context.Context, summaries []models.ReflectionSummaryItem) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	summaryModels := make([]models.ReflectionSummaryModel, 0, len(summaries))
+
+	for _, summary := range summaries {
+		status := summary.Status
+		if status == nil {
+			status = &models.ReflectionSummaryStatus{}
+		}
+		summaryModels = append(summaryModels, models.ReflectionSummaryModel{
+			ID:                    types.StringValue(summary.ID),
+			Name:                  types.StringValue(summary.Name),
+			ReflectionType:        types.StringValue(summary.ReflectionType),
+			ReflectionMode:        types.StringValue(summary.ReflectionMode),
+			DatasetID:             types.StringValue(summary.DatasetID),
+			DatasetType:           types.StringValue(summary.DatasetType),
+			DatasetPath:           stringListValue(ctx, summary.DatasetPath, &diags),
+			CreatedAt:             types.StringValue(summary.CreatedAt),
+			UpdatedAt:             types.StringValue(summary.UpdatedAt),
+			CurrentSizeBytes:      types.Int64Value(summary.CurrentSizeBytes),
+			TotalSizeBytes:        types.Int64Value(summary.TotalSizeBytes),
+			OutputRecords:         types.Int64Value(summary.OutputRecords),
+			ConsideredCount:       types.Int64Value(int64(summary.ConsideredCount)),
+			MatchedCount:          types.Int64Value(int64(summary.MatchedCount)),
+			ChosenCount:           types.Int64Value(int64(summary.ChosenCount)),
+			ConfigStatus:          types.StringValue(status.ConfigStatus),
+			RefreshStatus:         types.StringValue(status.RefreshStatus),
+			AvailabilityStatus:    types.StringValue(status.AvailabilityStatus),
+			CombinedStatus:        types.StringValue(status.CombinedStatus),
+			RefreshMethod:         types.StringValue(status.RefreshMethod),
+			FailureCount:          types.Int64Value(int64(status.FailureCount)),
+			LastFailureMessage:    types.StringValue(status.LastFailureMessage),
+			LastRefreshAt:         types.StringValue(status.LastDataFetchAt),
+			LastRefreshDurationMs: types.Int64Value(status.LastRefreshDurationMillis),
+			ExpiresAt:             types.StringValue(status.ExpiresAt),
+		})
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetReflectionSummaryAttrTypes()}, summaryModels)
+	diags.Append(listDiags...)
+	return list, diags
+}
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetRoleReferenceAttrTypes returns the attribute type definitions for RoleReference structures.
+func GetRoleReferenceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"type": types.StringType,
+	}
+}
+
+// ConvertRoleReferencesToTerraform converts API role references to a Terraform list.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - roles: The role references from the API response
+//
+// Returns:
+//   - types.List: The converted roles as a Terraform list (empty if there are no roles)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertRoleReferencesToTerraform(
+	ctx context.Context,
+	roles []models.RoleReference,
+) (types.List, diag.Diagnostics) {
+	attrTypes := GetRoleReferenceAttrTypes()
+
+	roleModels := make([]models.RoleReferenceModel, 0, len(roles))
+	for _, role := range roles {
+		roleType := types.StringNull()
+		if role.Type != "" {
+			roleType = types.StringValue(role.Type)
+		}
+		roleModels = append(roleModels, models.RoleReferenceModel{
+			ID:   types.StringValue(role.ID),
+			Name: types.StringValue(role.Name),
+			Type: roleType,
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: attrTypes}, roleModels)
+}
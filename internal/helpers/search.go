@@ -0,0 +1,203 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetSearchCatalogObjectAttrTypes returns the attribute type definitions for SearchCatalogObject structures.
+func GetSearchCatalogObjectAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"path":         types.ListType{ElemType: types.StringType},
+		"branch":       types.StringType,
+		"type":         types.StringType,
+		"labels":       types.ListType{ElemType: types.StringType},
+		"wiki":         types.StringType,
+		"owner_id":     types.StringType,
+		"owner_type":   types.StringType,
+		"owner_name":   types.StringType,
+		"created_at":   types.StringType,
+		"modified_at":  types.StringType,
+		"columns":      types.ListType{ElemType: types.StringType},
+		"function_sql": types.StringType,
+	}
+}
+
+// GetSearchQueriedDatasetAttrTypes returns the attribute type definitions for SearchQueriedDataset structures.
+func GetSearchQueriedDatasetAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"dataset_type": types.StringType,
+		"dataset_path": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// GetSearchJobAttrTypes returns the attribute type definitions for SearchJob structures.
+func GetSearchJobAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":               types.StringType,
+		"sql":              types.StringType,
+		"job_type":         types.StringType,
+		"job_state":        types.StringType,
+		"user_id":          types.StringType,
+		"user_name":        types.StringType,
+		"start_time":       types.StringType,
+		"finish_time":      types.StringType,
+		"error":            types.StringType,
+		"queried_datasets": types.ListType{ElemType: types.ObjectType{AttrTypes: GetSearchQueriedDatasetAttrTypes()}},
+	}
+}
+
+// GetSearchScriptAttrTypes returns the attribute type definitions for SearchScript structures.
+func GetSearchScriptAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"owner_id":    types.StringType,
+		"owner_type":  types.StringType,
+		"owner_name":  types.StringType,
+		"content":     types.StringType,
+		"created_at":  types.StringType,
+		"modified_at": types.StringType,
+	}
+}
+
+// GetSearchReflectionAttrTypes returns the attribute type definitions for SearchReflection structures.
+func GetSearchReflectionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.StringType,
+		"name":           types.StringType,
+		"dataset_type":   types.StringType,
+		"dataset_path":   types.ListType{ElemType: types.StringType},
+		"dataset_branch": types.StringType,
+		"created_at":     types.StringType,
+		"modified_at":    types.StringType,
+	}
+}
+
+// ConvertSearchResultsToTerraform splits search results by category and converts them to Terraform lists.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - results: The search results from the API responses
+//
+// Returns:
+//   - catalogObjects, jobs, scripts, reflections: The converted results of each category (empty if there are none)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertSearchResultsToTerraform(
+	ctx context.Context,
+	results []models.SearchResultObject,
+) (catalogObjects, jobs, scripts, reflections types.List, diags diag.Diagnostics) {
+	catalogObjectModels := []models.SearchCatalogObjectModel{}
+	jobModels := []models.SearchJobModel{}
+	scriptModels := []models.SearchScriptModel{}
+	reflectionModels := []models.SearchReflectionModel{}
+
+	for _, result := range results {
+		switch {
+		case result.CatalogObject != nil:
+			object := result.CatalogObject
+			ownerID, ownerType, ownerName := searchUserOrRole(object.Owner)
+			catalogObjectModels = append(catalogObjectModels, models.SearchCatalogObjectModel{
+				Path:        stringListValue(ctx, object.Path, &diags),
+				Branch:      types.StringValue(object.Branch),
+				Type:        types.StringValue(object.Type),
+				Labels:      stringListValue(ctx, object.Labels, &diags),
+				Wiki:        types.StringValue(object.Wiki),
+				OwnerID:     ownerID,
+				OwnerType:   ownerType,
+				OwnerName:   ownerName,
+				CreatedAt:   types.StringValue(object.CreatedAt),
+				ModifiedAt:  types.StringValue(object.ModifiedAt),
+				Columns:     stringListValue(ctx, object.Columns, &diags),
+				FunctionSQL: types.StringValue(object.FunctionSQL),
+			})
+		case result.JobObject != nil:
+			job := result.JobObject
+			userID, _, userName := searchUserOrRole(job.User)
+			datasets := make([]models.SearchQueriedDatasetModel, 0, len(job.QueriedDatasets))
+			for _, dataset := range job.QueriedDatasets {
+				datasets = append(datasets, models.SearchQueriedDatasetModel{
+					DatasetType: types.StringValue(dataset.DatasetType),
+					DatasetPath: stringListValue(ctx, dataset.DatasetPath, &diags),
+				})
+			}
+			queriedDatasets, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetSearchQueriedDatasetAttrTypes()}, datasets)
+			diags.Append(listDiags...)
+			jobModels = append(jobModels, models.SearchJobModel{
+				ID:              types.StringValue(job.ID),
+				SQL:             types.StringValue(job.SQL),
+				JobType:         types.StringValue(job.JobType),
+				JobState:        types.StringValue(job.JobState),
+				UserID:          userID,
+				UserName:        userName,
+				StartTime:       types.StringValue(job.StartTime),
+				FinishTime:      types.StringValue(job.FinishTime),
+				Error:           types.StringValue(job.Error),
+				QueriedDatasets: queriedDatasets,
+			})
+		case result.ScriptObject != nil:
+			script := result.ScriptObject
+			ownerID, ownerType, ownerName := searchUserOrRole(script.Owner)
+			scriptModels = append(scriptModels, models.SearchScriptModel{
+				ID:         types.StringValue(script.ID),
+				Name:       types.StringValue(script.Name),
+				OwnerID:    ownerID,
+				OwnerType:  ownerType,
+				OwnerName:  ownerName,
+				Content:    types.StringValue(script.Content),
+				CreatedAt:  types.StringValue(script.CreatedAt),
+				ModifiedAt: types.StringValue(script.ModifiedAt),
+			})
+		case result.ReflectionObject != nil:
+			reflection := result.ReflectionObject
+			reflectionModels = append(reflectionModels, models.SearchReflectionModel{
+				ID:            types.StringValue(reflection.ID),
+				Name:          types.StringValue(reflection.Name),
+				DatasetType:   types.StringValue(reflection.DatasetType),
+				DatasetPath:   stringListValue(ctx, reflection.DatasetPath, &diags),
+				DatasetBranch: types.StringValue(reflection.DatasetBranch),
+				CreatedAt:     types.StringValue(reflection.CreatedAt),
+				ModifiedAt:    types.StringValue(reflection.ModifiedAt),
+			})
+		}
+	}
+
+	var listDiags diag.Diagnostics
+	catalogObjects, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetSearchCatalogObjectAttrTypes()}, catalogObjectModels)
+	diags.Append(listDiags...)
+	jobs, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetSearchJobAttrTypes()}, jobModels)
+	diags.Append(listDiags...)
+	scripts, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetSearchScriptAttrTypes()}, scriptModels)
+	diags.Append(listDiags...)
+	reflections, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetSearchReflectionAttrTypes()}, reflectionModels)
+	diags.Append(listDiags...)
+
+	return catalogObjects, jobs, scripts, reflections, diags
+}
+
+// searchUserOrRole returns the ID, type and name of the owner or user of a search result, null when it has none
+func searchUserOrRole(userOrRole *models.SearchUserOrRole) (id, entityType, name types.String) {
+	if userOrRole == nil {
+		return types.StringNull(), types.StringNull(), types.StringNull()
+	}
+
+	name = types.StringValue(userOrRole.Username)
+	if userOrRole.Type == "ROLE" {
+		name = types.StringValue(userOrRole.RoleName)
+	}
+	return types.StringValue(userOrRole.ID), types.StringValue(userOrRole.Type), name
+}
+
+// stringListValue converts a string slice of an API response to a Terraform list, empty when nil
+func stringListValue(ctx context.Context, values []string, diags *diag.Diagnostics) types.List {
+	if values == nil {
+		values = []string{}
+	}
+	list, listDiags := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(listDiags...)
+	return list
+}
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetUsageAttrTypes returns the attribute type definitions for Usage structures.
+func GetUsageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"type":       types.StringType,
+		"start_time": types.StringType,
+		"end_time":   types.StringType,
+		"usage":      types.Float64Type,
+	}
+}
+
+// ConvertUsageToTerraform converts usage objects to a Terraform list and sums their usage.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - usage: The usage objects from the API responses
+//
+// Returns:
+//   - types.List: The converted list (empty if there is no usage)
+//   - float64: The total usage in Dremio Consumption Units (DCUs)
+//   - diag.Diagnostics: Any diagnostics encountered during conversion
+func ConvertUsageToTerraform(ctx context.Context, usage []models.UsageObject) (types.List, float64, diag.Diagnostics) {
+	usageModels := make([]models.UsageModel, 0, len(usage))
+	total := 0.0
+
+	for _, object := range usage {
+		usageModels = append(usageModels, models.UsageModel{
+			ID:        types.StringValue(object.ID),
+			Type:      types.StringValue(object.Type),
+			StartTime: types.StringValue(object.StartTime),
+			EndTime:   types.StringValue(object.EndTime),
+			Usage:     types.Float64Value(object.Usage),
+		})
+		total += object.Usage
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GetUsageAttrTypes()}, usageModels)
+	return list, total, diags
+}
@@ -144,9 +144,32 @@ type CloudUpdateRequest struct {
 	Attributes *CloudAttributes `json:"attributes"` // Cloud attributes (AWS or Azure specific)
 }
 
-// CloudAttributes represents cloud-specific attributes
-// This is a placeholder - actual structure varies by cloud provider (AWS/Azure)
-type CloudAttributes map[string]interface{}
+// CloudAttributes represents cloud-specific attributes, with exactly one of AWSProps or AzureProps set
+type CloudAttributes struct {
+	AWSProps   *CloudAWSProps   `json:"awsProps,omitempty"`   // Network and IAM settings of an AWS cloud
+	AzureProps *CloudAzureProps `json:"azureProps,omitempty"` // Network and identity settings of an Azure cloud
+	CloudTags  []CloudTag       `json:"cloudTags"`            // Tags applied to the compute resources, an empty list removes them
+}
+
+// CloudAWSProps represents the attributes of an AWS cloud
+type CloudAWSProps struct {
+	Region             string   `json:"region"`             // AWS region of the VPC
+	VpcID              string   `json:"vpcId"`              // ID of the VPC where engines run
+	SubnetIDs          []string `json:"subnetIds"`          // IDs of the subnets where engines run
+	RoleArn            string   `json:"roleArn"`            // ARN of the cross-account role Dremio assumes
+	InstanceProfileArn string   `json:"instanceProfileArn"` // ARN of the instance profile of the engine instances
+}
+
+// CloudAzureProps represents the attributes of an Azure cloud
+type CloudAzureProps struct {
+	TenantID       string `json:"tenantId"`       // Microsoft Entra tenant ID
+	SubscriptionID string `json:"subscriptionId"` // Azure subscription ID
+	ResourceGroup  string `json:"resourceGroup"`  // Resource group where engines run
+	Region         string `json:"region"`         // Azure region of the virtual network
+	VnetID         string `json:"vnetId"`         // ID of the virtual network where engines run
+	SubnetID       string `json:"subnetId"`       // ID of the subnet where engines run
+	ClientID       string `json:"clientId"`       // Application (client) ID Dremio uses to manage compute resources
+}
 
 // EngineRulesRequest represents a request to update engine routing rules
 type EngineRulesRequest struct {
@@ -197,7 +220,7 @@ type ExternalTokenProviderCreateRequest struct {
 	UserClaim string   `json:"userClaim"`         // Key name for the target claim in the JWT
 	IssuerURL string   `json:"issuerUrl"`         // URL that identifies the principal that issued the JWT
 	JwksURL   string   `json:"jwksUrl,omitempty"` // Endpoint that hosts the JWK Set
-	Enabled   bool     `json:"enabled,omitempty"` // If the provider is available
+	Enabled   *bool    `json:"enabled,omitempty"` // If the provider is available
 }
 
 // ExternalTokenProviderUpdateRequest represents a request to update an external token provider
@@ -207,13 +230,13 @@ type ExternalTokenProviderUpdateRequest struct {
 	UserClaim string   `json:"userClaim"`         // Key name for the target claim in the JWT
 	IssuerURL string   `json:"issuerUrl"`         // URL that identifies the principal that issued the JWT
 	JwksURL   string   `json:"jwksUrl,omitempty"` // Endpoint that hosts the JWK Set
-	Enabled   bool     `json:"enabled,omitempty"` // If the provider is available
+	Enabled   *bool    `json:"enabled,omitempty"` // If the provider is available
 }
 
 // IdentityProviderCreateRequest represents a request to create an identity provider
 type IdentityProviderCreateRequest struct {
 	Type         string `json:"type"`                // Type of identity provider (GENERIC_OIDC, AZURE_AD, OKTA)
-	IsActive     bool   `json:"isActive,omitempty"`  // Enable the provider as a login option
+	IsActive     *bool  `json:"isActive,omitempty"`  // Enable the provider as a login option
 	IssuerURL    string `json:"issuerUrl,omitempty"` // Issuer URL for generic OIDC
 	Domain       string `json:"domain,omitempty"`    // Publisher domain for Microsoft Entra ID
 	OktaURL      string `json:"oktaUrl,omitempty"`   // URL for Okta
@@ -221,6 +244,16 @@ type IdentityProviderCreateRequest struct {
 	ClientSecret string `json:"clientSecret"`        // Client secret
 }
 
+// IdentityProviderUpdateRequest represents a request to update an identity provider
+type IdentityProviderUpdateRequest struct {
+	IsActive     *bool  `json:"isActive,omitempty"`     // Enable the provider as a login option
+	IssuerURL    string `json:"issuerUrl,omitempty"`    // Issuer URL for generic OIDC
+	Domain       string `json:"domain,omitempty"`       // Publisher domain for Microsoft Entra ID
+	OktaURL      string `json:"oktaUrl,omitempty"`      // URL for Okta
+	ClientID     string `json:"clientID"`               // Client or application ID
+	ClientSecret string `json:"clientSecret,omitempty"` // Client secret, only sent when it changed
+}
+
 // PipeLoadFilesRequest represents a request to load files with a pipe
 type PipeLoadFilesRequest struct {
 	Files []PipeFile `json:"files"` // Paths and sizes of files to load
@@ -266,40 +299,40 @@ type ProjectCredentials struct {
 
 // ReflectionCreateRequest represents a request to create a Reflection
 type ReflectionCreateRequest struct {
-	Type                          string                   `json:"type"`                                    // RAW or AGGREGATION
-	Name                          string                   `json:"name"`                                    // Name of the Reflection
-	DatasetID                     string                   `json:"datasetId"`                               // ID of the dataset
-	Enabled                       bool                     `json:"enabled"`                                 // Whether the Reflection is enabled
-	EntityType                    string                   `json:"entityType"`                              // Always "reflection"
-	DisplayFields                 []map[string]interface{} `json:"displayFields,omitempty"`                 // Fields to display (for RAW)
-	DimensionFields               []map[string]interface{} `json:"dimensionFields,omitempty"`               // Dimension fields (for AGGREGATION)
-	MeasureFields                 []map[string]interface{} `json:"measureFields,omitempty"`                 // Measure fields (for AGGREGATION)
-	DistributionFields            []map[string]interface{} `json:"distributionFields,omitempty"`            // Fields for data distribution
-	PartitionFields               []map[string]interface{} `json:"partitionFields,omitempty"`               // Fields for partitioning
-	SortFields                    []map[string]interface{} `json:"sortFields,omitempty"`                    // Fields for sorting
-	PartitionDistributionStrategy string                   `json:"partitionDistributionStrategy,omitempty"` // CONSOLIDATED or STRIPED
-	CanView                       bool                     `json:"canView,omitempty"`                       // Whether user can view
-	CanAlter                      bool                     `json:"canAlter,omitempty"`                      // Whether user can alter
+	Type                          string                        `json:"type"`                                    // RAW or AGGREGATION
+	Name                          string                        `json:"name"`                                    // Name of the Reflection
+	DatasetID                     string                        `json:"datasetId"`                               // ID of the dataset
+	Enabled                       bool                          `json:"enabled"`                                 // Whether the Reflection is enabled
+	EntityType                    string                        `json:"entityType"`                              // Always "reflection"
+	DisplayFields                 []ReflectionDisplayField      `json:"displayFields,omitempty"`                 // Fields to display (for RAW)
+	DimensionFields               []ReflectionDimensionField    `json:"dimensionFields,omitempty"`               // Dimension fields (for AGGREGATION)
+	MeasureFields                 []ReflectionMeasureField      `json:"measureFields,omitempty"`                 // Measure fields (for AGGREGATION)
+	DistributionFields            []ReflectionDistributionField `json:"distributionFields,omitempty"`            // Fields for data distribution
+	PartitionFields               []ReflectionPartitionField    `json:"partitionFields,omitempty"`               // Fields for partitioning
+	SortFields                    []ReflectionSortField         `json:"sortFields,omitempty"`                    // Fields for sorting
+	PartitionDistributionStrategy string                        `json:"partitionDistributionStrategy,omitempty"` // CONSOLIDATED or STRIPED
+	CanView                       bool                          `json:"canView,omitempty"`                       // Whether user can view
+	CanAlter                      bool                          `json:"canAlter,omitempty"`                      // Whether user can alter
 }
 
 // ReflectionUpdateRequest represents a request to update a Reflection
 type ReflectionUpdateRequest struct {
-	ID                            string                   `json:"id"`                                      // Unique identifier
-	Type                          string                   `json:"type"`                                    // RAW or AGGREGATION
-	Name                          string                   `json:"name"`                                    // Name of the Reflection
-	Tag                           string                   `json:"tag"`                                     // Version tag for concurrency control
-	DatasetID                     string                   `json:"datasetId"`                               // ID of the dataset
-	Enabled                       bool                     `json:"enabled"`                                 // Whether the Reflection is enabled
-	EntityType                    string                   `json:"entityType"`                              // Always "reflection"
-	DisplayFields                 []map[string]interface{} `json:"displayFields,omitempty"`                 // Fields to display (for RAW)
-	DimensionFields               []map[string]interface{} `json:"dimensionFields,omitempty"`               // Dimension fields (for AGGREGATION)
-	MeasureFields                 []map[string]interface{} `json:"measureFields,omitempty"`                 // Measure fields (for AGGREGATION)
-	DistributionFields            []map[string]interface{} `json:"distributionFields,omitempty"`            // Fields for data distribution
-	PartitionFields               []map[string]interface{} `json:"partitionFields,omitempty"`               // Fields for partitioning
-	SortFields                    []map[string]interface{} `json:"sortFields,omitempty"`                    // Fields for sorting
-	PartitionDistributionStrategy string                   `json:"partitionDistributionStrategy,omitempty"` // CONSOLIDATED or STRIPED
-	CanView                       bool                     `json:"canView,omitempty"`                       // Whether user can view
-	CanAlter                      bool                     `json:"canAlter,omitempty"`                      // Whether user can alter
+	ID                            string                        `json:"id"`                                      // Unique identifier
+	Type                          string                        `json:"type"`                                    // RAW or AGGREGATION
+	Name                          string                        `json:"name"`                                    // Name of the Reflection
+	Tag                           string                        `json:"tag"`                                     // Version tag for concurrency control
+	DatasetID                     string                        `json:"datasetId"`                               // ID of the dataset
+	Enabled                       bool                          `json:"enabled"`                                 // Whether the Reflection is enabled
+	EntityType                    string                        `json:"entityType"`                              // Always "reflection"
+	DisplayFields                 []ReflectionDisplayField      `json:"displayFields,omitempty"`                 // Fields to display (for RAW)
+	DimensionFields               []ReflectionDimensionField    `json:"dimensionFields,omitempty"`               // Dimension fields (for AGGREGATION)
+	MeasureFields                 []ReflectionMeasureField      `json:"measureFields,omitempty"`                 // Measure fields (for AGGREGATION)
+	DistributionFields            []ReflectionDistributionField `json:"distributionFields,omitempty"`            // Fields for data distribution
+	PartitionFields               []ReflectionPartitionField    `json:"partitionFields,omitempty"`               // Fields for partitioning
+	SortFields                    []ReflectionSortField         `json:"sortFields,omitempty"`                    // Fields for sorting
+	PartitionDistributionStrategy string                        `json:"partitionDistributionStrategy,omitempty"` // CONSOLIDATED or STRIPED
+	CanView                       bool                          `json:"canView,omitempty"`                       // Whether user can view
+	CanAlter                      bool                          `json:"canAlter,omitempty"`                      // Whether user can alter
 }
 
 // JobBasedRecommendationsRequest represents a request to submit job IDs for Reflection recommendations
@@ -325,7 +358,7 @@ type ScriptCreateRequest struct {
 type ScriptUpdateRequest struct {
 	Name    string   `json:"name,omitempty"`    // Updated name for the script
 	Content string   `json:"content,omitempty"` // Updated SQL for the script
-	Context []string `json:"context,omitempty"` // Updated path where the SQL query should run
+	Context []string `json:"context"`           // Updated path where the SQL query should run, an empty list clears it
 	Owner   string   `json:"owner,omitempty"`   // Updated owner user ID
 }
 
@@ -336,8 +369,8 @@ type ScriptBatchDeleteRequest struct {
 
 // ScriptGrantsUpdateRequest represents a request to update script grants
 type ScriptGrantsUpdateRequest struct {
-	Users []ScriptGrantee `json:"users,omitempty"` // Array of user privilege grants
-	Roles []ScriptGrantee `json:"roles,omitempty"` // Array of role privilege grants
+	Users []ScriptGrantee `json:"users"` // Array of user privilege grants (replaces all existing user grants)
+	Roles []ScriptGrantee `json:"roles"` // Array of role privilege grants (replaces all existing role grants)
 }
 
 // ScriptGrantee represents a user or role with privileges on a script
@@ -424,6 +457,7 @@ type UserCreateRequest struct {
 	FirstName string `json:"firstName,omitempty"` // First name of the user
 	LastName  string `json:"lastName,omitempty"`  // Last name of the user
 	Email     string `json:"email"`               // Email address of the user
+	Password  string `json:"password,omitempty"`  // Password of the user (Dremio Software local users only)
 	Tag       string `json:"tag,omitempty"`       // Version tag for optimistic concurrency control
 }
 
@@ -434,6 +468,7 @@ type UserUpdateRequest struct {
 	FirstName string `json:"firstName,omitempty"` // Updated first name
 	LastName  string `json:"lastName,omitempty"`  // Updated last name
 	Email     string `json:"email,omitempty"`     // Updated email address
+	Password  string `json:"password,omitempty"`  // Updated password (Dremio Software local users only)
 	Tag       string `json:"tag,omitempty"`       // Version tag for optimistic concurrency control
 }
 
@@ -486,9 +521,9 @@ type MaintenanceTaskConfig struct {
 // BillingAccountUpdateRequest represents a request to update a billing account
 // Reference: https://docs.dremio.com/cloud/reference/api/billing/
 type BillingAccountUpdateRequest struct {
-	Name        string                 `json:"name,omitempty"`        // Updated name
-	Description string                 `json:"description,omitempty"` // Updated description
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`    // Additional metadata
+	Name        string                 `json:"name,omitempty"` // Updated name
+	Description string                 `json:"description"`    // Updated description, an empty string removes it
+	Metadata    map[string]interface{} `json:"metadata"`       // Additional metadata, an empty map removes it
 }
 
 // ========================================
@@ -507,7 +542,7 @@ type ArcticCatalogCreateRequest struct {
 type ArcticScheduleCreateRequest struct {
 	Name           string                 `json:"name"`                 // Name of the schedule
 	CronExpression string                 `json:"cronExpression"`       // Cron expression for the schedule
-	Enabled        bool                   `json:"enabled,omitempty"`    // Whether the schedule is enabled
+	Enabled        *bool                  `json:"enabled,omitempty"`    // Whether the schedule is enabled
 	TaskType       string                 `json:"taskType"`             // Type of task (OPTIMIZE, VACUUM, etc.)
 	Parameters     map[string]interface{} `json:"parameters,omitempty"` // Task-specific parameters
 	RequestID      string                 `json:"requestId,omitempty"`  // User-defined idempotency key
@@ -518,7 +553,7 @@ type ArcticScheduleUpdateRequest struct {
 	Name           string                 `json:"name,omitempty"`           // Updated name
 	CronExpression string                 `json:"cronExpression,omitempty"` // Updated cron expression
 	Enabled        *bool                  `json:"enabled,omitempty"`        // Updated enabled status
-	Parameters     map[string]interface{} `json:"parameters,omitempty"`     // Updated parameters
+	Parameters     map[string]interface{} `json:"parameters"`               // Updated parameters, an empty map removes them
 }
 
 type TableFormatRequest struct {
@@ -332,6 +332,7 @@ type ReflectionRecommendation struct {
 	MeasureFields   []ReflectionRecommendationMeasure   `json:"measureFields,omitempty"`   // Measure fields (for aggregation Reflections)
 	PartitionFields []ReflectionRecommendationField     `json:"partitionFields,omitempty"` // Partition fields
 	EntityType      string                              `json:"entityType"`                // Entity type
+	DatasetID       string                              `json:"datasetId,omitempty"`       // ID of the dataset the Reflection would be created on
 }
 
 // ReflectionRecommendationField represents a field in a Reflection recommendation
@@ -351,6 +352,17 @@ type ReflectionRecommendationMeasure struct {
 	MeasureTypeList []string `json:"measureTypeList,omitempty"` // List of measure types
 }
 
+// UsageBasedRecommendationsResponse represents a response for usage-based Reflection recommendations
+type UsageBasedRecommendationsResponse struct {
+	Data []UsageBasedRecommendation `json:"data,omitempty"` // List of usage-based recommendations
+}
+
+// UsageBasedRecommendation represents a Reflection recommended from the query history of the project
+type UsageBasedRecommendation struct {
+	RecommendationID      string                 `json:"recommendationId"`      // ID of the recommendation, used to create the Reflection
+	ReflectionRequestBody map[string]interface{} `json:"reflectionRequestBody"` // Body of the recommended Reflection
+}
+
 // ReflectionSummaryResponse represents a response for Reflection summaries
 // Reference: OpenAPI schema ReflectionSummaryResponse
 type ReflectionSummaryResponse struct {
@@ -674,3 +686,153 @@ type MaintenanceTaskResponse struct {
 	IsEnabled  bool                   `json:"isEnabled,omitempty"` // Whether the task is enabled
 	TaskConfig *MaintenanceTaskConfig `json:"config,omitempty"`    // An object that contains a fully qualified object name in the indicated catalog as the target for the maintenance task.
 }
+
+// UserResponse represents a response for a user
+// Reference: https://docs.dremio.com/cloud/reference/api/user/
+type UserResponse struct {
+	ID        string          `json:"id"`                  // Unique identifier of the user
+	Name      string          `json:"name,omitempty"`      // Username of the user
+	UserName  string          `json:"userName,omitempty"`  // Username of the user, as sent in create requests
+	FirstName string          `json:"firstName,omitempty"` // First name of the user
+	LastName  string          `json:"lastName,omitempty"`  // Last name of the user
+	Email     string          `json:"email,omitempty"`     // Email address of the user
+	Tag       string          `json:"tag,omitempty"`       // Version tag for optimistic concurrency control
+	Active    *bool           `json:"active,omitempty"`    // Whether the user is active
+	Roles     []RoleReference `json:"roles,omitempty"`     // Roles the user is a direct member of
+}
+
+// RoleReference represents a role a user or role is a member of
+type RoleReference struct {
+	ID   string `json:"id"`             // Unique identifier of the role
+	Name string `json:"name"`           // Name of the role
+	Type string `json:"type,omitempty"` // Type of the role (INTERNAL, EXTERNAL or SYSTEM)
+}
+
+// RoleResponse represents a response for a role
+// Reference: https://docs.dremio.com/cloud/reference/api/role/
+type RoleResponse struct {
+	ID          string          `json:"id"`                    // Unique identifier of the role
+	Name        string          `json:"name"`                  // Name of the role
+	Type        string          `json:"type,omitempty"`        // Type of the role (INTERNAL, EXTERNAL or SYSTEM)
+	Description string          `json:"description,omitempty"` // Description of the role
+	MemberCount int64           `json:"memberCount,omitempty"` // Number of direct members of the role
+	Tag         string          `json:"tag,omitempty"`         // Version tag for optimistic concurrency control
+	Roles       []RoleReference `json:"roles,omitempty"`       // Roles this role is a member of
+}
+
+// RoleMembersResponse represents a page of role members
+type RoleMembersResponse struct {
+	Data          []RoleMember `json:"data"`                    // Direct members of the role
+	NextPageToken string       `json:"nextPageToken,omitempty"` // Token to retrieve the next page of members
+}
+
+// RoleMember represents a direct member of a role
+type RoleMember struct {
+	ID   string `json:"id"`             // Unique identifier of the user or role
+	Name string `json:"name,omitempty"` // Name of the user or role
+	Type string `json:"type"`           // Type of member (USER or ROLE)
+}
+
+// ProjectResponse represents a response for a project
+// Reference: https://docs.dremio.com/cloud/reference/api/projects/
+type ProjectResponse struct {
+	ID             string             `json:"id"`                       // Unique identifier of the project
+	Name           string             `json:"name"`                     // User-defined name of the project
+	Type           string             `json:"type,omitempty"`           // Type of the project
+	CloudID        string             `json:"cloudId,omitempty"`        // ID of the cloud where compute resources are created
+	ProjectStore   string             `json:"projectStore,omitempty"`   // S3 bucket or Azure storage container
+	State          string             `json:"state,omitempty"`          // State of the project (CREATING, ACTIVE, INACTIVE, ...)
+	CatalogID      string             `json:"catalogId,omitempty"`      // ID of the project's primary catalog
+	CatalogName    string             `json:"catalogName,omitempty"`    // Name of the project's primary catalog
+	CreatedBy      string             `json:"createdBy,omitempty"`      // ID of the user who created the project
+	ModifiedBy     string             `json:"modifiedBy,omitempty"`     // ID of the user who last modified the project
+	CreatedAt      string             `json:"createdAt,omitempty"`      // Date and time the project was created (UTC)
+	ModifiedAt     string             `json:"modifiedAt,omitempty"`     // Date and time the project was last modified (UTC)
+	LastStateError *ProjectStateError `json:"lastStateError,omitempty"` // Error of the last failed state transition
+}
+
+// ProjectStateError represents the error of a failed project state transition
+type ProjectStateError struct {
+	Error     string `json:"error"`               // Error message
+	Timestamp string `json:"timestamp,omitempty"` // Date and time the error occurred (UTC)
+}
+
+// ArcticCatalogResponse represents a response for an Arctic catalog
+// Reference: https://docs.dremio.com/cloud/reference/api/arctic/catalogs/
+type ArcticCatalogResponse struct {
+	ID         string `json:"id"`                   // Unique identifier of the catalog
+	Name       string `json:"name"`                 // User-defined name of the catalog
+	OwnerID    string `json:"ownerId,omitempty"`    // ID of the user who owns the catalog
+	OwnerName  string `json:"ownerName,omitempty"`  // Name of the user who owns the catalog
+	CreatedAt  string `json:"createdAt,omitempty"`  // Date and time the catalog was created (UTC)
+	ModifiedAt string `json:"modifiedAt,omitempty"` // Date and time the catalog was last modified (UTC)
+}
+
+// ArcticScheduleResponse represents a response for an Arctic catalog schedule
+// Reference: https://docs.dremio.com/cloud/reference/api/arctic/schedules/
+type ArcticScheduleResponse struct {
+	ID             string                 `json:"id"`                   // Unique identifier of the schedule
+	Name           string                 `json:"name"`                 // Name of the schedule
+	CronExpression string                 `json:"cronExpression"`       // Cron expression for the schedule
+	Enabled        bool                   `json:"enabled"`              // Whether the schedule is enabled
+	TaskType       string                 `json:"taskType"`             // Type of task (OPTIMIZE or VACUUM)
+	Parameters     map[string]interface{} `json:"parameters,omitempty"` // Task-specific parameters
+	CreatedAt      string                 `json:"createdAt,omitempty"`  // Date and time the schedule was created (UTC)
+	ModifiedAt     string                 `json:"modifiedAt,omitempty"` // Date and time the schedule was last modified (UTC)
+}
+
+// IdentityProviderResponse represents a response for an identity provider
+// Reference: https://docs.dremio.com/cloud/reference/api/identity-providers/
+type IdentityProviderResponse struct {
+	ID          string `json:"id"`                    // Unique identifier of the identity provider
+	Type        string `json:"type"`                  // Type of identity provider (GENERIC_OIDC, AZURE_AD, OKTA)
+	IsActive    bool   `json:"isActive"`              // Whether the provider is a login option
+	IssuerURL   string `json:"issuerUrl,omitempty"`   // Issuer URL for generic OIDC
+	Domain      string `json:"domain,omitempty"`      // Publisher domain for Microsoft Entra ID
+	OktaURL     string `json:"oktaUrl,omitempty"`     // URL for Okta
+	ClientID    string `json:"clientID"`              // Client or application ID
+	RedirectURL string `json:"redirectUrl,omitempty"` // Redirect URL to register with the identity provider
+}
+
+// ExternalTokenProviderResponse represents a response for an external token provider
+// Reference: https://docs.dremio.com/cloud/reference/api/external-token-providers/
+type ExternalTokenProviderResponse struct {
+	ID         string   `json:"id"`                   // Unique identifier of the external token provider
+	Name       string   `json:"name"`                 // Name of the external token provider
+	Audience   []string `json:"audience"`             // Intended recipients of the JWT
+	UserClaim  string   `json:"userClaim"`            // Key name for the target claim in the JWT
+	IssuerURL  string   `json:"issuerUrl"`            // URL that identifies the principal that issued the JWT
+	JwksURL    string   `json:"jwksUrl,omitempty"`    // Endpoint that hosts the JWK Set
+	Enabled    bool     `json:"enabled"`              // If the provider is available
+	CreatedAt  string   `json:"createdAt,omitempty"`  // Date and time the provider was created (UTC)
+	ModifiedAt string   `json:"modifiedAt,omitempty"` // Date and time the provider was last modified (UTC)
+}
+
+// BillingAccountResponse represents a response for a billing account
+// Reference: https://docs.dremio.com/cloud/reference/api/billing/
+type BillingAccountResponse struct {
+	ID          string                 `json:"id"`                    // Unique identifier of the billing account
+	Name        string                 `json:"name"`                  // Name of the billing account
+	Description string                 `json:"description,omitempty"` // Description of the billing account
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`    // Additional metadata
+	CreatedAt   string                 `json:"createdAt,omitempty"`   // Date and time the billing account was created (UTC)
+	ModifiedAt  string                 `json:"modifiedAt,omitempty"`  // Date and time the billing account was last modified (UTC)
+}
+
+// CloudResponse represents a response for a cloud
+// Reference: https://docs.dremio.com/cloud/reference/api/clouds/
+type CloudResponse struct {
+	ID             string           `json:"id"`                       // Unique identifier of the cloud
+	Name           string           `json:"name"`                     // User-defined name of the cloud
+	State          string           `json:"state,omitempty"`          // State of the cloud (PROVISIONING, ACTIVE, FAILED, ...)
+	Attributes     *CloudAttributes `json:"attributes,omitempty"`     // Cloud attributes (AWS or Azure specific)
+	CreatedAt      string           `json:"createdAt,omitempty"`      // Date and time the cloud was created (UTC)
+	ModifiedAt     string           `json:"modifiedAt,omitempty"`     // Date and time the cloud was last modified (UTC)
+	LastStateError *CloudStateError `json:"lastStateError,omitempty"` // Error of the last failed state transition
+}
+
+// CloudStateError represents the error of a failed cloud state transition
+type CloudStateError struct {
+	Error     string `json:"error"`               // Error message
+	Timestamp string `json:"timestamp,omitempty"` // Date and time the error occurred (UTC)
+}
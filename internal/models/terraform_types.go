@@ -20,6 +20,7 @@ type DremioSourceModel struct {
 	AccelerationRefreshOnDataChanges types.Bool           `tfsdk:"acceleration_refresh_on_data_changes"`
 	AccessControlList                types.Object         `tfsdk:"access_control_list"`
 	Tag                              types.String         `tfsdk:"tag"`
+	ProjectID                        types.String         `tfsdk:"project_id"`
 }
 
 // DremioSourceDataSourceModel describes the data source data model.
@@ -40,6 +41,7 @@ type DremioSourceDataSourceModel struct {
 	AccessControlList            types.Object         `tfsdk:"access_control_list"`
 	Permissions                  types.List           `tfsdk:"permissions"`
 	Owner                        types.Object         `tfsdk:"owner"`
+	ProjectID                    types.String         `tfsdk:"project_id"`
 }
 
 // MetadataPolicyModel represents the metadata policy nested object
@@ -93,6 +95,30 @@ type DremioFolderModel struct {
 	Path              types.List   `tfsdk:"path"`
 	AccessControlList types.Object `tfsdk:"access_control_list"`
 	Tag               types.String `tfsdk:"tag"`
+	ProjectID         types.String `tfsdk:"project_id"`
+}
+
+// DremioSpaceModel describes the space resource data model.
+type DremioSpaceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	AccessControlList types.Object `tfsdk:"access_control_list"`
+	Tag               types.String `tfsdk:"tag"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	ProjectID         types.String `tfsdk:"project_id"`
+}
+
+// DremioSpaceDataSourceModel describes the space data source data model.
+type DremioSpaceDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Tag               types.String `tfsdk:"tag"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	Children          types.List   `tfsdk:"children"`
+	AccessControlList types.Object `tfsdk:"access_control_list"`
+	Permissions       types.List   `tfsdk:"permissions"`
+	Owner             types.Object `tfsdk:"owner"`
+	ProjectID         types.String `tfsdk:"project_id"`
 }
 
 // DremioUDFModel describes the UDF resource data model.
@@ -106,6 +132,7 @@ type DremioUDFModel struct {
 	ReturnType        types.String `tfsdk:"return_type"`
 	AccessControlList types.Object `tfsdk:"access_control_list"`
 	Tag               types.String `tfsdk:"tag"`
+	ProjectID         types.String `tfsdk:"project_id"`
 }
 
 // DremioFolderDataSourceModel describes the folder data source data model.
@@ -120,6 +147,7 @@ type DremioFolderDataSourceModel struct {
 	Permissions       types.List   `tfsdk:"permissions"`
 	Owner             types.Object `tfsdk:"owner"`
 	StorageURI        types.String `tfsdk:"storage_uri"`
+	ProjectID         types.String `tfsdk:"project_id"`
 }
 
 // FolderChildModel represents a child object within a folder
@@ -147,6 +175,7 @@ type DremioUDFDataSourceModel struct {
 	AccessControlList types.Object `tfsdk:"access_control_list"`
 	Permissions       types.List   `tfsdk:"permissions"`
 	Owner             types.Object `tfsdk:"owner"`
+	ProjectID         types.String `tfsdk:"project_id"`
 }
 
 // DremioFileDataSourceModel describes the file data source data model.
@@ -154,6 +183,7 @@ type DremioFileDataSourceModel struct {
 	ID         types.String `tfsdk:"id"`
 	Path       types.List   `tfsdk:"path"`
 	EntityType types.String `tfsdk:"entity_type"`
+	ProjectID  types.String `tfsdk:"project_id"`
 }
 
 // DremioTableDataSourceModel describes the table data source data model.
@@ -169,6 +199,7 @@ type DremioTableDataSourceModel struct {
 	Owner                        types.Object `tfsdk:"owner"`
 	Fields                       types.String `tfsdk:"fields"` // JSON string representation of table fields
 	ApproximateStatisticsAllowed types.Bool   `tfsdk:"approximate_statistics_allowed"`
+	ProjectID                    types.String `tfsdk:"project_id"`
 }
 
 // TableFieldModel represents a field/column in a table or view
@@ -281,18 +312,229 @@ type GranteeRequestModel struct {
 	ID          types.String `tfsdk:"id"`
 }
 
-// ScriptGranteeModel represents a user or role with privileges on a script (request-only)
+// ScriptGranteeModel represents a user or role with privileges on a script
 type ScriptGranteeModel struct {
 	GranteeID  types.String `tfsdk:"grantee_id"`
-	Privileges types.List   `tfsdk:"privileges"`
+	Privileges types.Set    `tfsdk:"privileges"`
 }
 
-// CloudTagModel represents a cloud tag (AWS or Azure) (request-only)
+// CloudTagModel represents a cloud tag (AWS or Azure)
 type CloudTagModel struct {
 	Key   types.String `tfsdk:"key"`
 	Value types.String `tfsdk:"value"`
 }
 
+// ReflectionFieldModel represents a Reflection field referenced only by name (display, distribution, partition and sort fields)
+type ReflectionFieldModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// ReflectionDimensionFieldModel represents a dimension field of an aggregation Reflection
+type ReflectionDimensionFieldModel struct {
+	Name        types.String `tfsdk:"name"`
+	Granularity types.String `tfsdk:"granularity"`
+}
+
+// ReflectionMeasureFieldModel represents a measure field of an aggregation Reflection
+type ReflectionMeasureFieldModel struct {
+	Name            types.String `tfsdk:"name"`
+	MeasureTypeList types.List   `tfsdk:"measure_type_list"`
+}
+
+// ReflectionStatusModel represents the status of a Reflection (response-only)
+type ReflectionStatusModel struct {
+	Config         types.String `tfsdk:"config"`
+	Refresh        types.String `tfsdk:"refresh"`
+	Availability   types.String `tfsdk:"availability"`
+	CombinedStatus types.String `tfsdk:"combined_status"`
+	FailureCount   types.Int64  `tfsdk:"failure_count"`
+	LastDataFetch  types.String `tfsdk:"last_data_fetch"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+}
+
+// ReflectionRecommendationModel represents a recommended Reflection (response-only)
+type ReflectionRecommendationModel struct {
+	RecommendationID types.String `tfsdk:"recommendation_id"`
+	Type             types.String `tfsdk:"type"`
+	DatasetID        types.String `tfsdk:"dataset_id"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	DisplayFields    types.List   `tfsdk:"display_fields"`
+	DimensionFields  types.List   `tfsdk:"dimension_fields"`
+	MeasureFields    types.List   `tfsdk:"measure_fields"`
+	PartitionFields  types.List   `tfsdk:"partition_fields"`
+	Body             types.String `tfsdk:"body"`
+}
+
+// RoleReferenceModel represents a role a user is a member of (response-only)
+type RoleReferenceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// ProjectSummaryModel represents a single project of the projects data source (response-only)
+type ProjectSummaryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	CloudID     types.String `tfsdk:"cloud_id"`
+	State       types.String `tfsdk:"state"`
+	CatalogID   types.String `tfsdk:"catalog_id"`
+	CatalogName types.String `tfsdk:"catalog_name"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// JobResultColumnModel represents a column of SQL query results (response-only)
+type JobResultColumnModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// SearchCatalogObjectModel represents a catalog object found by the search data source (response-only)
+type SearchCatalogObjectModel struct {
+	Path        types.List   `tfsdk:"path"`
+	Branch      types.String `tfsdk:"branch"`
+	Type        types.String `tfsdk:"type"`
+	Labels      types.List   `tfsdk:"labels"`
+	Wiki        types.String `tfsdk:"wiki"`
+	OwnerID     types.String `tfsdk:"owner_id"`
+	OwnerType   types.String `tfsdk:"owner_type"`
+	OwnerName   types.String `tfsdk:"owner_name"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	ModifiedAt  types.String `tfsdk:"modified_at"`
+	Columns     types.List   `tfsdk:"columns"`
+	FunctionSQL types.String `tfsdk:"function_sql"`
+}
+
+// SearchJobModel represents a job found by the search data source (response-only)
+type SearchJobModel struct {
+	ID              types.String `tfsdk:"id"`
+	SQL             types.String `tfsdk:"sql"`
+	JobType         types.String `tfsdk:"job_type"`
+	JobState        types.String `tfsdk:"job_state"`
+	UserID          types.String `tfsdk:"user_id"`
+	UserName        types.String `tfsdk:"user_name"`
+	StartTime       types.String `tfsdk:"start_time"`
+	FinishTime      types.String `tfsdk:"finish_time"`
+	Error           types.String `tfsdk:"error"`
+	QueriedDatasets types.List   `tfsdk:"queried_datasets"` // List of SearchQueriedDatasetModel
+}
+
+// SearchQueriedDatasetModel represents a dataset queried by a job found by the search data source (response-only)
+type SearchQueriedDatasetModel struct {
+	DatasetType types.String `tfsdk:"dataset_type"`
+	DatasetPath types.List   `tfsdk:"dataset_path"`
+}
+
+// SearchScriptModel represents a script found by the search data source (response-only)
+type SearchScriptModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	OwnerID    types.String `tfsdk:"owner_id"`
+	OwnerType  types.String `tfsdk:"owner_type"`
+	OwnerName  types.String `tfsdk:"owner_name"`
+	Content    types.String `tfsdk:"content"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	ModifiedAt types.String `tfsdk:"modified_at"`
+}
+
+// SearchReflectionModel represents a Reflection found by the search data source (response-only)
+type SearchReflectionModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	DatasetType   types.String `tfsdk:"dataset_type"`
+	DatasetPath   types.List   `tfsdk:"dataset_path"`
+	DatasetBranch types.String `tfsdk:"dataset_branch"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	ModifiedAt    types.String `tfsdk:"modified_at"`
+}
+
+// LineageSourceModel represents a source in the lineage of a dataset (response-only)
+type LineageSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Path types.List   `tfsdk:"path"`
+	Type types.String `tfsdk:"type"`
+}
+
+// LineageDatasetModel represents a parent or child dataset in the lineage of a dataset (response-only)
+type LineageDatasetModel struct {
+	ID        types.String `tfsdk:"id"`
+	Path      types.List   `tfsdk:"path"`
+	Type      types.String `tfsdk:"type"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// JobReflectionModel represents a Reflection related to a job (response-only)
+type JobReflectionModel struct {
+	ReflectionID types.String `tfsdk:"reflection_id"`
+	DatasetID    types.String `tfsdk:"dataset_id"`
+	Relationship types.String `tfsdk:"relationship"`
+}
+
+// UsageModel represents the usage of a project or engine over a period (response-only)
+type UsageModel struct {
+	ID        types.String  `tfsdk:"id"`
+	Type      types.String  `tfsdk:"type"`
+	StartTime types.String  `tfsdk:"start_time"`
+	EndTime   types.String  `tfsdk:"end_time"`
+	Usage     types.Float64 `tfsdk:"usage"`
+}
+
+// CloudAWSPropsModel represents the attributes of an AWS cloud
+type CloudAWSPropsModel struct {
+	Region             types.String `tfsdk:"region"`
+	VpcID              types.String `tfsdk:"vpc_id"`
+	SubnetIDs          types.List   `tfsdk:"subnet_ids"`
+	RoleArn            types.String `tfsdk:"role_arn"`
+	InstanceProfileArn types.String `tfsdk:"instance_profile_arn"`
+}
+
+// CloudAzurePropsModel represents the attributes of an Azure cloud
+type CloudAzurePropsModel struct {
+	TenantID       types.String `tfsdk:"tenant_id"`
+	SubscriptionID types.String `tfsdk:"subscription_id"`
+	ResourceGroup  types.String `tfsdk:"resource_group"`
+	Region         types.String `tfsdk:"region"`
+	VnetID         types.String `tfsdk:"vnet_id"`
+	SubnetID       types.String `tfsdk:"subnet_id"`
+	ClientID       types.String `tfsdk:"client_id"`
+}
+
+// PipeFileModel represents a file to load with a pipe (request-only)
+type PipeFileModel struct {
+	Path types.String `tfsdk:"path"`
+	Size types.String `tfsdk:"size"`
+}
+
+// ReflectionSummaryModel represents the configuration and status of a Reflection (response-only)
+type ReflectionSummaryModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	ReflectionType        types.String `tfsdk:"reflection_type"`
+	ReflectionMode        types.String `tfsdk:"reflection_mode"`
+	DatasetID             types.String `tfsdk:"dataset_id"`
+	DatasetType           types.String `tfsdk:"dataset_type"`
+	DatasetPath           types.List   `tfsdk:"dataset_path"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	UpdatedAt             types.String `tfsdk:"updated_at"`
+	CurrentSizeBytes      types.Int64  `tfsdk:"current_size_bytes"`
+	TotalSizeBytes        types.Int64  `tfsdk:"total_size_bytes"`
+	OutputRecords         types.Int64  `tfsdk:"output_records"`
+	ConsideredCount       types.Int64  `tfsdk:"considered_count"`
+	MatchedCount          types.Int64  `tfsdk:"matched_count"`
+	ChosenCount           types.Int64  `tfsdk:"chosen_count"`
+	ConfigStatus          types.String `tfsdk:"config_status"`
+	RefreshStatus         types.String `tfsdk:"refresh_status"`
+	AvailabilityStatus    types.String `tfsdk:"availability_status"`
+	CombinedStatus        types.String `tfsdk:"combined_status"`
+	RefreshMethod         types.String `tfsdk:"refresh_method"`
+	FailureCount          types.Int64  `tfsdk:"failure_count"`
+	LastFailureMessage    types.String `tfsdk:"last_failure_message"`
+	LastRefreshAt         types.String `tfsdk:"last_refresh_at"`
+	LastRefreshDurationMs types.Int64  `tfsdk:"last_refresh_duration_ms"`
+	ExpiresAt             types.String `tfsdk:"expires_at"`
+}
+
 // RuleInfoModel represents a single engine routing rule (request-only)
 type RuleInfoModel struct {
 	Name          types.String `tfsdk:"name"`
@@ -334,7 +576,9 @@ type DremioTableModel struct {
 	AccelerationRefreshPolicy types.Object `tfsdk:"acceleration_refresh_policy"`
 	Format                    types.Object `tfsdk:"format"`
 	AccessControlList         types.Object `tfsdk:"access_control_list"`
+	Ref                       types.String `tfsdk:"ref"`
 	Tag                       types.String `tfsdk:"tag"`
+	ProjectID                 types.String `tfsdk:"project_id"`
 }
 
 // DremioDatasetTagsModel describes the dataset tags resource data model.
@@ -342,6 +586,7 @@ type DremioDatasetTagsModel struct {
 	DatasetID types.String `tfsdk:"dataset_id"`
 	Tags      types.List   `tfsdk:"tags"`
 	Version   types.String `tfsdk:"version"`
+	ProjectID types.String `tfsdk:"project_id"`
 }
 
 // DremioDatasetTagsDataSourceModel describes the dataset tags datasource data model.
@@ -349,6 +594,7 @@ type DremioDatasetTagsDataSourceModel struct {
 	DatasetID types.String `tfsdk:"dataset_id"`
 	Tags      types.List   `tfsdk:"tags"`
 	Version   types.String `tfsdk:"version"`
+	ProjectID types.String `tfsdk:"project_id"`
 }
 
 // DremioDatasetWikiModel describes the dataset wiki resource data model.
@@ -356,6 +602,7 @@ type DremioDatasetWikiModel struct {
 	DatasetID types.String `tfsdk:"dataset_id"`
 	Text      types.String `tfsdk:"text"`
 	Version   types.Int64  `tfsdk:"version"`
+	ProjectID types.String `tfsdk:"project_id"`
 }
 
 // DremioDatasetWikiDataSourceModel describes the dataset wiki datasource data model.
@@ -363,6 +610,7 @@ type DremioDatasetWikiDataSourceModel struct {
 	DatasetID types.String `tfsdk:"dataset_id"`
 	Text      types.String `tfsdk:"text"`
 	Version   types.Int64  `tfsdk:"version"`
+	ProjectID types.String `tfsdk:"project_id"`
 }
 
 // DremioGrantsModel describes the grants resource data model.
@@ -370,6 +618,7 @@ type DremioGrantsModel struct {
 	CatalogObjectID     types.String `tfsdk:"catalog_object_id"`
 	Grants              types.Set    `tfsdk:"grants"` // Set of GranteeRequestModel
 	AvailablePrivileges types.List   `tfsdk:"available_privileges"`
+	ProjectID           types.String `tfsdk:"project_id"`
 }
 
 // DremioGrantsDataSourceModel describes the grants data source data model.
@@ -377,6 +626,7 @@ type DremioGrantsDataSourceModel struct {
 	CatalogObjectID     types.String `tfsdk:"catalog_object_id"`
 	Grants              types.Set    `tfsdk:"grants"` // Set of GranteesResponse as objects
 	AvailablePrivileges types.List   `tfsdk:"available_privileges"`
+	ProjectID           types.String `tfsdk:"project_id"`
 }
 
 // DremioViewModel describes the view resource data model.
@@ -388,8 +638,10 @@ type DremioViewModel struct {
 	SQL               types.String `tfsdk:"sql"`
 	SQLContext        types.List   `tfsdk:"sql_context"`
 	AccessControlList types.Object `tfsdk:"access_control_list"`
+	Ref               types.String `tfsdk:"ref"`
 	Tag               types.String `tfsdk:"tag"`
 	Fields            types.String `tfsdk:"fields"` // JSON string representation of view fields
+	ProjectID         types.String `tfsdk:"project_id"`
 }
 
 // DremioViewDataSourceModel describes the view data source data model.
@@ -405,6 +657,7 @@ type DremioViewDataSourceModel struct {
 	AccessControlList types.Object `tfsdk:"access_control_list"`
 	Permissions       types.List   `tfsdk:"permissions"`
 	Owner             types.Object `tfsdk:"owner"`
+	ProjectID         types.String `tfsdk:"project_id"`
 }
 
 // DremioEngineModel describes the engine resource data model.
@@ -428,6 +681,7 @@ type DremioEngineModel struct {
 	StatusChangedAt           types.String `tfsdk:"status_changed_at"`
 	InstanceFamily            types.String `tfsdk:"instance_family"`
 	AdditionalEngineStateInfo types.String `tfsdk:"additional_engine_state_info"`
+	ProjectID                 types.String `tfsdk:"project_id"`
 }
 
 // DremioEngineDataSourceModel describes the engine data source model.
@@ -448,6 +702,7 @@ type DremioEngineDataSourceModel struct {
 	QueriedAt                 types.String `tfsdk:"queried_at"`
 	StatusChangedAt           types.String `tfsdk:"status_changed_at"`
 	AdditionalEngineStateInfo types.String `tfsdk:"additional_engine_state_info"`
+	ProjectID                 types.String `tfsdk:"project_id"`
 }
 
 // DremioEngineRuleSetModel describes the engine rule set resource data model.
@@ -455,6 +710,7 @@ type DremioEngineRuleSetModel struct {
 	RuleInfos       types.List   `tfsdk:"rule_infos"`        // List of RuleInfoModel
 	RuleInfoDefault types.Object `tfsdk:"rule_info_default"` // The default rule (cannot be deleted)
 	Tag             types.String `tfsdk:"tag"`               // UUID for routing JDBC queries
+	ProjectID       types.String `tfsdk:"project_id"`
 }
 
 // DremioDataMaintenanceModel describes the data maintenance task resource data model.
@@ -465,6 +721,7 @@ type DremioDataMaintenanceModel struct {
 	SourceName types.String `tfsdk:"source_name"` // Name of the source - computed
 	IsEnabled  types.Bool   `tfsdk:"is_enabled"`  // Whether the task is enabled
 	TableID    types.String `tfsdk:"table_id"`    // Fully qualified table name (e.g., "folder1.folder2.table1")
+	ProjectID  types.String `tfsdk:"project_id"`
 }
 
 // DremioDataMaintenanceDataSourceModel describes the data maintenance task data source model.
@@ -475,4 +732,360 @@ type DremioDataMaintenanceDataSourceModel struct {
 	SourceName types.String `tfsdk:"source_name"` // Name of the source
 	IsEnabled  types.Bool   `tfsdk:"is_enabled"`  // Whether the task is enabled
 	TableID    types.String `tfsdk:"table_id"`    // Fully qualified table name (e.g., "folder1.folder2.table1")
+	ProjectID  types.String `tfsdk:"project_id"`
+}
+
+// DremioReflectionModel describes the Reflection resource data model.
+type DremioReflectionModel struct {
+	ID                            types.String `tfsdk:"id"`                              // Unique identifier of the Reflection
+	Name                          types.String `tfsdk:"name"`                            // Name of the Reflection
+	Type                          types.String `tfsdk:"type"`                            // RAW or AGGREGATION
+	DatasetID                     types.String `tfsdk:"dataset_id"`                      // ID of the dataset the Reflection is based on
+	Enabled                       types.Bool   `tfsdk:"enabled"`                         // Whether the Reflection is enabled
+	DisplayFields                 types.List   `tfsdk:"display_fields"`                  // List of ReflectionFieldModel
+	DimensionFields               types.List   `tfsdk:"dimension_fields"`                // List of ReflectionDimensionFieldModel
+	MeasureFields                 types.List   `tfsdk:"measure_fields"`                  // List of ReflectionMeasureFieldModel
+	DistributionFields            types.List   `tfsdk:"distribution_fields"`             // List of ReflectionFieldModel
+	PartitionFields               types.List   `tfsdk:"partition_fields"`                // List of ReflectionFieldModel
+	SortFields                    types.List   `tfsdk:"sort_fields"`                     // List of ReflectionFieldModel
+	PartitionDistributionStrategy types.String `tfsdk:"partition_distribution_strategy"` // CONSOLIDATED or STRIPED
+	Tag                           types.String `tfsdk:"tag"`                             // Version tag for optimistic concurrency control
+	CreatedAt                     types.String `tfsdk:"created_at"`                      // Date and time the Reflection was created - computed
+	UpdatedAt                     types.String `tfsdk:"updated_at"`                      // Date and time the Reflection was last updated - computed
+	CurrentSizeBytes              types.Int64  `tfsdk:"current_size_bytes"`              // Size of the latest Reflection data - computed
+	TotalSizeBytes                types.Int64  `tfsdk:"total_size_bytes"`                // Size of all Reflection data - computed
+	Status                        types.Object `tfsdk:"status"`                          // ReflectionStatusModel - computed
+	ProjectID                     types.String `tfsdk:"project_id"`
+}
+
+// DremioReflectionRecommendationsDataSourceModel describes the Reflection recommendations data source model.
+type DremioReflectionRecommendationsDataSourceModel struct {
+	JobIDs          types.List   `tfsdk:"job_ids"`         // Job IDs to get recommendations for
+	DatasetID       types.String `tfsdk:"dataset_id"`      // Dataset ID to get recommendations for
+	ReflectionType  types.String `tfsdk:"reflection_type"` // RAW or AGGREGATION (only with dataset_id)
+	Recommendations types.List   `tfsdk:"recommendations"` // List of ReflectionRecommendationModel
+	ProjectID       types.String `tfsdk:"project_id"`
+}
+
+// DremioRecommendedReflectionModel describes the resource data model of a Reflection created from a usage-based recommendation.
+type DremioRecommendedReflectionModel struct {
+	ID               types.String `tfsdk:"id"`                // Unique identifier of the created Reflection
+	RecommendationID types.String `tfsdk:"recommendation_id"` // ID of the usage-based recommendation
+	Name             types.String `tfsdk:"name"`              // Name of the Reflection - computed
+	Type             types.String `tfsdk:"type"`              // RAW or AGGREGATION - computed
+	DatasetID        types.String `tfsdk:"dataset_id"`        // ID of the dataset the Reflection is based on - computed
+	Enabled          types.Bool   `tfsdk:"enabled"`           // Whether the Reflection is enabled - computed
+	Tag              types.String `tfsdk:"tag"`               // Version tag - computed
+	Status           types.Object `tfsdk:"status"`            // ReflectionStatusModel - computed
+	ProjectID        types.String `tfsdk:"project_id"`
+}
+
+// DremioUserModel describes the user resource data model.
+type DremioUserModel struct {
+	ID        types.String `tfsdk:"id"`         // Unique identifier of the user
+	UserName  types.String `tfsdk:"user_name"`  // Username of the user
+	FirstName types.String `tfsdk:"first_name"` // First name of the user
+	LastName  types.String `tfsdk:"last_name"`  // Last name of the user
+	Email     types.String `tfsdk:"email"`      // Email address of the user
+	Password  types.String `tfsdk:"password"`   // Password (Dremio Software local users only)
+	Active    types.Bool   `tfsdk:"active"`     // Whether the user is active - computed
+	Tag       types.String `tfsdk:"tag"`        // Version tag - computed
+}
+
+// DremioUserDataSourceModel describes the user data source data model.
+type DremioUserDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	UserName  types.String `tfsdk:"user_name"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Active    types.Bool   `tfsdk:"active"`
+	Roles     types.List   `tfsdk:"roles"` // List of RoleReferenceModel
+}
+
+// DremioRoleModel describes the role resource data model.
+type DremioRoleModel struct {
+	ID          types.String `tfsdk:"id"`          // Unique identifier of the role
+	Name        types.String `tfsdk:"name"`        // Name of the role
+	Description types.String `tfsdk:"description"` // Description of the role
+	Type        types.String `tfsdk:"type"`        // Type of the role - computed
+	Tag         types.String `tfsdk:"tag"`         // Version tag - computed
+}
+
+// DremioRoleDataSourceModel describes the role data source data model.
+type DremioRoleDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	MemberCount types.Int64  `tfsdk:"member_count"`
+}
+
+// DremioRoleMembershipModel describes the role membership resource data model.
+type DremioRoleMembershipModel struct {
+	ID      types.String `tfsdk:"id"`       // Same as role_id
+	RoleID  types.String `tfsdk:"role_id"`  // ID of the role
+	UserIDs types.Set    `tfsdk:"user_ids"` // IDs of the users that are members of the role
+}
+
+// DremioScriptModel describes the script resource data model.
+type DremioScriptModel struct {
+	ID         types.String `tfsdk:"id"`          // Unique identifier of the script
+	Name       types.String `tfsdk:"name"`        // Name of the script
+	Content    types.String `tfsdk:"content"`     // SQL of the script
+	Context    types.List   `tfsdk:"context"`     // Path where the SQL query runs
+	Owner      types.String `tfsdk:"owner"`       // User ID of the owner
+	CreatedAt  types.String `tfsdk:"created_at"`  // Creation date - computed
+	CreatedBy  types.String `tfsdk:"created_by"`  // Creator user ID - computed
+	ModifiedAt types.String `tfsdk:"modified_at"` // Last modification date - computed
+	ModifiedBy types.String `tfsdk:"modified_by"` // Last modifier user ID - computed
+	ProjectID  types.String `tfsdk:"project_id"`
+}
+
+// DremioScriptGrantsModel describes the script grants resource data model.
+type DremioScriptGrantsModel struct {
+	ScriptID  types.String `tfsdk:"script_id"`
+	Users     types.Set    `tfsdk:"users"` // Set of ScriptGranteeModel
+	Roles     types.Set    `tfsdk:"roles"` // Set of ScriptGranteeModel
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+// DremioProjectModel describes the project resource data model.
+type DremioProjectModel struct {
+	ID           types.String `tfsdk:"id"`            // Unique identifier of the project
+	Name         types.String `tfsdk:"name"`          // Name of the project
+	CloudID      types.String `tfsdk:"cloud_id"`      // ID of the cloud for compute resources
+	ProjectStore types.String `tfsdk:"project_store"` // S3 bucket or Azure storage container
+	Credentials  types.Object `tfsdk:"credentials"`   // ProjectCredentialsModel - sensitive, never read back
+	Type         types.String `tfsdk:"type"`          // Type of the project
+	CatalogName  types.String `tfsdk:"catalog_name"`  // Name of the primary catalog (AWS only)
+	CatalogID    types.String `tfsdk:"catalog_id"`    // ID of the primary catalog - computed
+	State        types.String `tfsdk:"state"`         // State of the project - computed
+	CreatedAt    types.String `tfsdk:"created_at"`    // Creation date - computed
+	CreatedBy    types.String `tfsdk:"created_by"`    // Creator user ID - computed
+}
+
+// DremioProjectsDataSourceModel describes the projects data source data model.
+type DremioProjectsDataSourceModel struct {
+	State    types.String `tfsdk:"state"`    // Optional filter on the project state
+	Projects types.List   `tfsdk:"projects"` // List of ProjectSummaryModel - computed
+}
+
+// DremioSQLQueryDataSourceModel describes the SQL query data source data model.
+type DremioSQLQueryDataSourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	SQL       types.String `tfsdk:"sql"`
+	Context   types.List   `tfsdk:"context"`   // List of String
+	Timeout   types.String `tfsdk:"timeout"`   // Go duration string
+	RowLimit  types.Int64  `tfsdk:"row_limit"` // Maximum number of rows to read
+	JobID     types.String `tfsdk:"job_id"`    // ID of the job that ran the query - computed
+	RowCount  types.Int64  `tfsdk:"row_count"` // Number of rows the query returned - computed
+	Truncated types.Bool   `tfsdk:"truncated"` // Whether rows were dropped because of row_limit - computed
+	Columns   types.List   `tfsdk:"columns"`   // List of JobResultColumnModel - computed
+	Rows      types.List   `tfsdk:"rows"`      // List of Map of String - computed
+	RowsJSON  types.String `tfsdk:"rows_json"` // Rows as a JSON array - computed
+}
+
+// DremioSQLStatementModel describes the SQL statement resource data model.
+type DremioSQLStatementModel struct {
+	ProjectID      types.String `tfsdk:"project_id"`
+	ID             types.String `tfsdk:"id"`              // ID of the job that ran create_sql - computed
+	CreateSQL      types.String `tfsdk:"create_sql"`      // SQL run on create
+	DestroySQL     types.String `tfsdk:"destroy_sql"`     // SQL run on destroy
+	UpdateSQL      types.String `tfsdk:"update_sql"`      // SQL run when create_sql or update_sql change
+	ReadSQL        types.String `tfsdk:"read_sql"`        // SQL run on refresh for drift detection
+	ExpectedResult types.String `tfsdk:"expected_result"` // Rows read_sql must return as JSON
+	Context        types.List   `tfsdk:"context"`         // List of String
+	Timeout        types.String `tfsdk:"timeout"`         // Go duration string
+	JobID          types.String `tfsdk:"job_id"`          // ID of the job of the last statement - computed
+	ReadResult     types.String `tfsdk:"read_result"`     // Rows returned by read_sql as JSON - computed
+}
+
+// DremioRowAccessPolicyModel describes the row access policy resource data model.
+type DremioRowAccessPolicyModel struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ID          types.String `tfsdk:"id"`           // Same as dataset_id - computed
+	DatasetID   types.String `tfsdk:"dataset_id"`   // ID of the table or view
+	UDFPath     types.List   `tfsdk:"udf_path"`     // List of String, path of the policy UDF
+	Columns     types.List   `tfsdk:"columns"`      // List of String, columns passed to the UDF
+	DatasetPath types.List   `tfsdk:"dataset_path"` // List of String - computed
+}
+
+// DremioColumnMaskingPolicyModel describes the column masking policy resource data model.
+type DremioColumnMaskingPolicyModel struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ID          types.String `tfsdk:"id"`           // <dataset_id>/<column> - computed
+	DatasetID   types.String `tfsdk:"dataset_id"`   // ID of the table or view
+	Column      types.String `tfsdk:"column"`       // Name of the masked column
+	UDFPath     types.List   `tfsdk:"udf_path"`     // List of String, path of the policy UDF
+	Arguments   types.List   `tfsdk:"arguments"`    // List of String, columns passed to the UDF
+	DatasetPath types.List   `tfsdk:"dataset_path"` // List of String - computed
+}
+
+// DremioArcticCatalogModel describes the Arctic catalog resource data model.
+type DremioArcticCatalogModel struct {
+	ID        types.String `tfsdk:"id"`         // Unique identifier of the catalog
+	Name      types.String `tfsdk:"name"`       // Name of the catalog
+	OwnerID   types.String `tfsdk:"owner_id"`   // ID of the owner - computed
+	OwnerName types.String `tfsdk:"owner_name"` // Name of the owner - computed
+	CreatedAt types.String `tfsdk:"created_at"` // Creation date - computed
+}
+
+// DremioArcticScheduleModel describes the Arctic schedule resource data model.
+type DremioArcticScheduleModel struct {
+	ID             types.String         `tfsdk:"id"`              // Unique identifier of the schedule
+	CatalogID      types.String         `tfsdk:"catalog_id"`      // ID of the Arctic catalog
+	Name           types.String         `tfsdk:"name"`            // Name of the schedule
+	CronExpression types.String         `tfsdk:"cron_expression"` // Cron expression of the schedule
+	TaskType       types.String         `tfsdk:"task_type"`       // OPTIMIZE or VACUUM
+	Parameters     jsontypes.Normalized `tfsdk:"parameters"`      // Task-specific parameters as JSON
+	Enabled        types.Bool           `tfsdk:"enabled"`         // Whether the schedule runs
+	CreatedAt      types.String         `tfsdk:"created_at"`      // Creation date - computed
+	ModifiedAt     types.String         `tfsdk:"modified_at"`     // Last modification date - computed
+}
+
+// DremioCatalogReferenceModel describes the catalog branch and catalog tag resource data model.
+type DremioCatalogReferenceModel struct {
+	ID         types.String `tfsdk:"id"`          // <source>/<name>
+	Source     types.String `tfsdk:"source"`      // Name of the versioned source
+	Name       types.String `tfsdk:"name"`        // Name of the branch or tag
+	FromBranch types.String `tfsdk:"from_branch"` // Branch the reference is created from
+	FromHash   types.String `tfsdk:"from_hash"`   // Commit hash the reference is created at
+	Hash       types.String `tfsdk:"hash"`        // Current commit hash - computed
+	ProjectID  types.String `tfsdk:"project_id"`
+}
+
+// DremioIdentityProviderModel describes the identity provider resource data model.
+type DremioIdentityProviderModel struct {
+	ID           types.String `tfsdk:"id"`            // Unique identifier of the identity provider
+	Type         types.String `tfsdk:"type"`          // GENERIC_OIDC, AZURE_AD or OKTA
+	IsActive     types.Bool   `tfsdk:"is_active"`     // Whether the provider is a login option
+	IssuerURL    types.String `tfsdk:"issuer_url"`    // Issuer URL (GENERIC_OIDC)
+	Domain       types.String `tfsdk:"domain"`        // Publisher domain (AZURE_AD)
+	OktaURL      types.String `tfsdk:"okta_url"`      // Okta URL (OKTA)
+	ClientID     types.String `tfsdk:"client_id"`     // Client or application ID
+	ClientSecret types.String `tfsdk:"client_secret"` // Client secret - sensitive, never read back
+	RedirectURL  types.String `tfsdk:"redirect_url"`  // Redirect URL - computed
+}
+
+// DremioExternalTokenProviderModel describes the external token provider resource data model.
+type DremioExternalTokenProviderModel struct {
+	ID        types.String `tfsdk:"id"`         // Unique identifier of the external token provider
+	Name      types.String `tfsdk:"name"`       // Name of the provider
+	Audience  types.List   `tfsdk:"audience"`   // Intended recipients of the JWT
+	UserClaim types.String `tfsdk:"user_claim"` // Claim holding the Dremio user name
+	IssuerURL types.String `tfsdk:"issuer_url"` // Issuer of the JWT
+	JwksURL   types.String `tfsdk:"jwks_url"`   // Endpoint that hosts the JWK Set
+	Enabled   types.Bool   `tfsdk:"enabled"`    // Whether the provider is available
+	CreatedAt types.String `tfsdk:"created_at"` // Creation date - computed
+}
+
+// DremioPersonalAccessTokenModel describes the personal access token ephemeral resource data model.
+type DremioPersonalAccessTokenModel struct {
+	UserID               types.String `tfsdk:"user_id"`                // ID of the user the token belongs to
+	Label                types.String `tfsdk:"label"`                  // Description of the token
+	MillisecondsToExpire types.Int64  `tfsdk:"milliseconds_to_expire"` // Lifespan of the token
+	ID                   types.String `tfsdk:"id"`                     // Token ID - computed
+	Token                types.String `tfsdk:"token"`                  // Token value - computed, sensitive
+	ExpiresAt            types.String `tfsdk:"expires_at"`             // Expiration date - computed
+}
+
+// DremioSearchDataSourceModel describes the search data source data model.
+type DremioSearchDataSourceModel struct {
+	Query          types.String `tfsdk:"query"`           // Search string
+	Filter         types.String `tfsdk:"filter"`          // Optional CEL filter expression
+	MaxResults     types.Int64  `tfsdk:"max_results"`     // Maximum number of results to read
+	Truncated      types.Bool   `tfsdk:"truncated"`       // Whether more results were available - computed
+	CatalogObjects types.List   `tfsdk:"catalog_objects"` // List of SearchCatalogObjectModel - computed
+	Jobs           types.List   `tfsdk:"jobs"`            // List of SearchJobModel - computed
+	Scripts        types.List   `tfsdk:"scripts"`         // List of SearchScriptModel - computed
+	Reflections    types.List   `tfsdk:"reflections"`     // List of SearchReflectionModel - computed
+	ProjectID      types.String `tfsdk:"project_id"`
+}
+
+// DremioDatasetLineageDataSourceModel describes the dataset lineage data source data model.
+type DremioDatasetLineageDataSourceModel struct {
+	DatasetID types.String `tfsdk:"dataset_id"` // ID of the dataset
+	Sources   types.List   `tfsdk:"sources"`    // List of LineageSourceModel - computed
+	Parents   types.List   `tfsdk:"parents"`    // List of LineageDatasetModel - computed
+	Children  types.List   `tfsdk:"children"`   // List of LineageDatasetModel - computed
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+// DremioJobDataSourceModel describes the job data source data model.
+type DremioJobDataSourceModel struct {
+	JobID        types.String `tfsdk:"job_id"`        // ID of the job
+	JobState     types.String `tfsdk:"job_state"`     // State of the job - computed
+	QueryType    types.String `tfsdk:"query_type"`    // Type of the job - computed
+	User         types.String `tfsdk:"user"`          // User who ran the job - computed
+	QueryText    types.String `tfsdk:"query_text"`    // SQL of the job - computed
+	RowCount     types.Int64  `tfsdk:"row_count"`     // Number of rows returned - computed
+	ErrorMessage types.String `tfsdk:"error_message"` // Error of a failed job - computed
+	StartedAt    types.String `tfsdk:"started_at"`    // Start date - computed
+	EndedAt      types.String `tfsdk:"ended_at"`      // End date - computed
+	Accelerated  types.Bool   `tfsdk:"accelerated"`   // Whether a Reflection was chosen - computed
+	Reflections  types.List   `tfsdk:"reflections"`   // List of JobReflectionModel - computed
+	ProjectID    types.String `tfsdk:"project_id"`
+}
+
+// DremioUsageDataSourceModel describes the usage data source data model.
+type DremioUsageDataSourceModel struct {
+	StartTime  types.String  `tfsdk:"start_time"`  // Start of the reporting range
+	EndTime    types.String  `tfsdk:"end_time"`    // End of the reporting range
+	GroupBy    types.String  `tfsdk:"group_by"`    // PROJECT or ENGINE
+	Usage      types.List    `tfsdk:"usage"`       // List of UsageModel - computed
+	TotalUsage types.Float64 `tfsdk:"total_usage"` // Sum of the usage in DCUs - computed
+}
+
+// DremioBillingAccountModel describes the billing account resource data model.
+type DremioBillingAccountModel struct {
+	ID          types.String         `tfsdk:"id"`          // ID of the existing billing account
+	Name        types.String         `tfsdk:"name"`        // Name of the billing account
+	Description types.String         `tfsdk:"description"` // Optional description
+	Metadata    jsontypes.Normalized `tfsdk:"metadata"`    // Optional metadata as JSON
+	CreatedAt   types.String         `tfsdk:"created_at"`  // Creation date - computed
+	ModifiedAt  types.String         `tfsdk:"modified_at"` // Last modification date - computed
+}
+
+// DremioCloudModel describes the cloud resource data model.
+type DremioCloudModel struct {
+	ID        types.String `tfsdk:"id"`         // Unique identifier of the cloud
+	Name      types.String `tfsdk:"name"`       // Name of the cloud
+	AWS       types.Object `tfsdk:"aws"`        // CloudAWSPropsModel - set for AWS clouds
+	Azure     types.Object `tfsdk:"azure"`      // CloudAzurePropsModel - set for Azure clouds
+	CloudTags types.List   `tfsdk:"cloud_tags"` // List of CloudTagModel
+	State     types.String `tfsdk:"state"`      // State of the cloud - computed
+	CreatedAt types.String `tfsdk:"created_at"` // Creation date - computed
+}
+
+// DremioPipeModel describes the pipe resource data model.
+type DremioPipeModel struct {
+	ID                         types.String `tfsdk:"id"`                           // Name of the pipe - computed
+	Name                       types.String `tfsdk:"name"`                         // Name of the pipe
+	Table                      types.List   `tfsdk:"table"`                        // Path of the target Iceberg table
+	Branch                     types.String `tfsdk:"branch"`                       // Optional branch of the target table
+	StorageLocation            types.String `tfsdk:"storage_location"`             // Source and folder the files are read from
+	FileFormat                 types.String `tfsdk:"file_format"`                  // Optional CSV, JSON or PARQUET
+	FormatOptions              types.String `tfsdk:"format_options"`               // Optional COPY INTO format options
+	DedupeLookbackPeriod       types.Int64  `tfsdk:"dedupe_lookback_period"`       // Optional days a file is deduplicated for
+	NotificationProvider       types.String `tfsdk:"notification_provider"`        // Optional provider of file notifications
+	NotificationQueueReference types.String `tfsdk:"notification_queue_reference"` // Optional queue of file notifications
+	Running                    types.Bool   `tfsdk:"running"`                      // Whether the pipe loads new files
+	PipeState                  types.String `tfsdk:"pipe_state"`                   // State of the pipe - computed
+	ProjectID                  types.String `tfsdk:"project_id"`
+}
+
+// DremioPipeLoadFilesModel describes the pipe load files action data model.
+type DremioPipeLoadFilesModel struct {
+	PipeName  types.String `tfsdk:"pipe_name"` // Name of the pipe
+	Files     types.List   `tfsdk:"files"`     // List of PipeFileModel
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+// DremioReflectionsDataSourceModel describes the reflections data source data model.
+type DremioReflectionsDataSourceModel struct {
+	DatasetID   types.String `tfsdk:"dataset_id"`   // Optional dataset ID filter
+	DatasetPath types.List   `tfsdk:"dataset_path"` // Optional dataset path filter
+	Reflections types.List   `tfsdk:"reflections"`  // List of ReflectionSummaryModel - computed
+	ProjectID   types.String `tfsdk:"project_id"`
 }
@@ -2,20 +2,32 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
+	dremioActions "github.com/carlos-ffs/dremio-terraform-provider/internal/actions"
 	client "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
 	dremioDatasources "github.com/carlos-ffs/dremio-terraform-provider/internal/datasources"
+	dremioEphemeralResources "github.com/carlos-ffs/dremio-terraform-provider/internal/ephemeralresources"
 	dremioResources "github.com/carlos-ffs/dremio-terraform-provider/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var _ provider.ProviderWithEphemeralResources = &DremioProvider{}
+var _ provider.ProviderWithActions = &DremioProvider{}
+
 type DremioProvider struct {
 	// version is set to the provider version on release, "dev" when the
 	// provider is built and ran locally, and "test" when running acceptance
@@ -26,8 +38,14 @@ type DremioProvider struct {
 type dremioProviderModel struct {
 	Host                types.String `tfsdk:"host"`
 	PersonalAccessToken types.String `tfsdk:"personal_access_token"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	ExternalToken       types.String `tfsdk:"external_token"`
+	ExternalTokenFile   types.String `tfsdk:"external_token_file"`
 	ProjectId           types.String `tfsdk:"project_id"`
 	Ptype               types.String `tfsdk:"type"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait        types.String `tfsdk:"retry_max_wait"`
 }
 
 func (p *DremioProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -48,13 +66,42 @@ func (p *DremioProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Sensitive:           true,
 				MarkdownDescription: "Dremio Personal Access Token",
 			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Dremio Software username. Used with `password` instead of `personal_access_token` to log in to a self-hosted cluster",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Dremio Software password. Used with `username`",
+			},
+			"external_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "JWT issued by an external token provider (e.g. GitHub OIDC, Azure AD), exchanged for a short-lived Dremio access token through `/oauth/token`. Used instead of `personal_access_token`",
+			},
+			"external_token_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a file containing the external JWT. The file is read again every time the access token is refreshed, so it can be rotated while Terraform runs",
+			},
 			"type": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Dremio Account Type. Defaults to cloud",
+				MarkdownDescription: "Dremio Account Type (`cloud` or `software`). Defaults to cloud",
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.TypeCloud, client.TypeSoftware),
+				},
 			},
 			"project_id": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Dremio Project ID. Required for Dremio Cloud",
+				MarkdownDescription: "Default Dremio Cloud project ID for project-scoped resources and data sources. Each of them can override it with its own `project_id`. Not used on Dremio Software",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum number of retries for requests failing with 429, 502, 503, 504 or a connection error. Only idempotent requests are retried. Set to 0 to disable retries. Defaults to %d", client.DefaultMaxRetries),
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait between two retries, as a Go duration string (e.g. `30s`, `2m`). Also caps the `Retry-After` header. Defaults to %s", client.DefaultRetryMaxWait),
 			},
 		},
 	}
@@ -64,9 +111,15 @@ func (p *DremioProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	// Retrieve provider data from configuration
 
 	personalAccessToken := os.Getenv("DREMIO_PAT")
+	username := os.Getenv("DREMIO_USERNAME")
+	password := os.Getenv("DREMIO_PASSWORD")
+	externalToken := os.Getenv("DREMIO_EXTERNAL_TOKEN")
+	externalTokenFile := os.Getenv("DREMIO_EXTERNAL_TOKEN_FILE")
 	host := os.Getenv("DREMIO_HOST")
 	ptype := os.Getenv("DREMIO_TYPE")
 	projectId := os.Getenv("DREMIO_PROJECT_ID")
+	maxRetries := os.Getenv("DREMIO_MAX_RETRIES")
+	retryMaxWait := os.Getenv("DREMIO_RETRY_MAX_WAIT")
 
 	var config dremioProviderModel
 	diags := req.Config.Get(ctx, &config)
@@ -83,12 +136,30 @@ func (p *DremioProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if config.PersonalAccessToken.ValueString() != "" {
 		personalAccessToken = config.PersonalAccessToken.ValueString()
 	}
+	if config.Username.ValueString() != "" {
+		username = config.Username.ValueString()
+	}
+	if config.Password.ValueString() != "" {
+		password = config.Password.ValueString()
+	}
+	if config.ExternalToken.ValueString() != "" {
+		externalToken = config.ExternalToken.ValueString()
+	}
+	if config.ExternalTokenFile.ValueString() != "" {
+		externalTokenFile = config.ExternalTokenFile.ValueString()
+	}
 	if config.Ptype.ValueString() != "" {
 		ptype = config.Ptype.ValueString()
 	}
 	if config.ProjectId.ValueString() != "" {
 		projectId = config.ProjectId.ValueString()
 	}
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = strconv.FormatInt(config.MaxRetries.ValueInt64(), 10)
+	}
+	if config.RetryMaxWait.ValueString() != "" {
+		retryMaxWait = config.RetryMaxWait.ValueString()
+	}
 
 	if host == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -99,24 +170,68 @@ func (p *DremioProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
-	if personalAccessToken == "" {
+	if ptype == "" {
+		ptype = client.TypeCloud
+	}
+	if ptype != client.TypeCloud && ptype != client.TypeSoftware {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Dremio Type",
+			fmt.Sprintf("The value %q is not a valid Dremio type. Valid values are %q and %q, set in the configuration or with the DREMIO_TYPE environment variable.", ptype, client.TypeCloud, client.TypeSoftware),
+		)
+	}
+
+	if externalToken != "" && externalTokenFile != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("external_token"),
+			"Conflicting Dremio External Token Settings",
+			"Only one of external_token (DREMIO_EXTERNAL_TOKEN) or external_token_file (DREMIO_EXTERNAL_TOKEN_FILE) can be set.",
+		)
+	}
+	// A PAT takes precedence, then an external JWT, then (Software only) a username and password
+	switch {
+	case personalAccessToken != "":
+	case externalToken != "" || externalTokenFile != "":
+	case ptype == client.TypeSoftware:
+		if username == "" || password == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("personal_access_token"),
+				"Missing Dremio Software Credentials",
+				"The provider cannot create the Dremio API client as neither a personal access token nor a username and password are configured. "+
+					"Set personal_access_token (or the DREMIO_PAT environment variable), or both username and password (or the DREMIO_USERNAME and DREMIO_PASSWORD environment variables).",
+			)
+		}
+	default:
 		resp.Diagnostics.AddAttributeError(
 			path.Root("personal_access_token"),
 			"Unknown Dremio API Personal Access Token",
 			"The provider cannot create the Dremio API client as there is an unknown configuration value for the Dremio API personal access token. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the DREMIO_PAT environment variable.",
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the DREMIO_PAT environment variable. "+
+				"Alternatively, set external_token or external_token_file to authenticate with an external JWT.",
 		)
 	}
-	if ptype == "" {
-		ptype = "cloud"
+	retry := client.DefaultRetryConfig()
+	if maxRetries != "" {
+		value, err := strconv.Atoi(maxRetries)
+		if err != nil || value < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Dremio Max Retries",
+				fmt.Sprintf("The value %q is not a valid number of retries. It must be a non-negative integer, set in the configuration or with the DREMIO_MAX_RETRIES environment variable.", maxRetries),
+			)
+		}
+		retry.MaxRetries = value
 	}
-	if ptype == "cloud" && projectId == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("project_id"),
-			"Unknown Dremio Project ID",
-			"The provider cannot create the Dremio API client as there is an unknown configuration value for the Dremio Project ID. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the DREMIO_PROJECT_ID environment variable.",
-		)
+	if retryMaxWait != "" {
+		value, err := time.ParseDuration(retryMaxWait)
+		if err != nil || value <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Dremio Retry Max Wait",
+				fmt.Sprintf("The value %q is not a valid duration. It must be a positive Go duration string such as \"30s\", set in the configuration or with the DREMIO_RETRY_MAX_WAIT environment variable.", retryMaxWait),
+			)
+		}
+		retry.MaxWait = value
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -124,7 +239,17 @@ func (p *DremioProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	// Create a new Dremio client using the configuration values
-	client, err := client.NewClient(&host, &personalAccessToken, &ptype, &projectId)
+	client, err := client.NewClient(client.Config{
+		Host:                host,
+		PersonalAccessToken: personalAccessToken,
+		Username:            username,
+		Password:            password,
+		ExternalToken:       externalToken,
+		ExternalTokenFile:   externalTokenFile,
+		Type:                ptype,
+		ProjectId:           projectId,
+		Retry:               retry,
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Dremio API Client",
@@ -135,10 +260,12 @@ func (p *DremioProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Make the Dremio client available during DataSource and Resource
-	// type Configure methods.
+	// Make the Dremio client available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -146,16 +273,27 @@ func (p *DremioProvider) DataSources(_ context.Context) []func() datasource.Data
 	return []func() datasource.DataSource{
 		dremioDatasources.NewDremioSourceDataSource,
 		dremioDatasources.NewDremioFolderDataSource,
+		dremioDatasources.NewDremioSpaceDataSource,
 		dremioDatasources.NewDremioFileDataSource,
 		dremioDatasources.NewDremioTableDataSource,
 		dremioDatasources.NewDremioUDFDataSource,
 		dremioDatasources.NewDremioDatasetTagsDataSource,
 		dremioDatasources.NewDremioDatasetWikiDataSource,
+		dremioDatasources.NewDremioDatasetLineageDataSource,
 		dremioDatasources.NewDremioViewDataSource,
 		dremioDatasources.NewDremioGrantsDataSource,
 		dremioDatasources.NewDremioEngineDataSource,
 		dremioDatasources.NewDremioEngineRuleSetDataSource,
 		dremioDatasources.NewDremioDataMaintenanceTaskDataSource,
+		dremioDatasources.NewDremioReflectionRecommendationsDataSource,
+		dremioDatasources.NewDremioReflectionsDataSource,
+		dremioDatasources.NewDremioUserDataSource,
+		dremioDatasources.NewDremioRoleDataSource,
+		dremioDatasources.NewDremioProjectsDataSource,
+		dremioDatasources.NewDremioSQLQueryDataSource,
+		dremioDatasources.NewDremioSearchDataSource,
+		dremioDatasources.NewDremioJobDataSource,
+		dremioDatasources.NewDremioUsageDataSource,
 	}
 }
 
@@ -164,6 +302,7 @@ func (p *DremioProvider) Resources(_ context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		dremioResources.NewDremioSourceResource,
 		dremioResources.NewDremioFolderResource,
+		dremioResources.NewDremioSpaceResource,
 		dremioResources.NewDremioTableResource,
 		dremioResources.NewDremioUDFResource,
 		dremioResources.NewDremioDatasetTagsResource,
@@ -173,6 +312,40 @@ func (p *DremioProvider) Resources(_ context.Context) []func() resource.Resource
 		dremioResources.NewDremioEngineResource,
 		dremioResources.NewDremioEngineRuleSetResource,
 		dremioResources.NewDremioDataMaintenanceResource,
+		dremioResources.NewDremioReflectionResource,
+		dremioResources.NewDremioRecommendedReflectionResource,
+		dremioResources.NewDremioUserResource,
+		dremioResources.NewDremioRoleResource,
+		dremioResources.NewDremioRoleMembershipResource,
+		dremioResources.NewDremioScriptResource,
+		dremioResources.NewDremioScriptGrantsResource,
+		dremioResources.NewDremioProjectResource,
+		dremioResources.NewDremioSQLStatementResource,
+		dremioResources.NewDremioRowAccessPolicyResource,
+		dremioResources.NewDremioColumnMaskingPolicyResource,
+		dremioResources.NewDremioArcticCatalogResource,
+		dremioResources.NewDremioArcticScheduleResource,
+		dremioResources.NewDremioCatalogBranchResource,
+		dremioResources.NewDremioCatalogTagResource,
+		dremioResources.NewDremioIdentityProviderResource,
+		dremioResources.NewDremioExternalTokenProviderResource,
+		dremioResources.NewDremioBillingAccountResource,
+		dremioResources.NewDremioCloudResource,
+		dremioResources.NewDremioPipeResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *DremioProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		dremioEphemeralResources.NewDremioPersonalAccessTokenEphemeralResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *DremioProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		dremioActions.NewDremioPipeLoadFilesAction,
 	}
 }
 
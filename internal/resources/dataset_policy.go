@@ -0,0 +1,158 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+)
+
+// policyStatementTimeout is how long the policy DDL and SHOW CREATE statements may run
+const policyStatementTimeout = 5 * time.Minute
+
+// rowAccessPolicyPattern matches the row access policy clause of a table or view definition
+var rowAccessPolicyPattern = regexp.MustCompile(`(?is)\bROW\s+ACCESS\s+POLICY\s+([^(]+?)\s*\(([^)]*)\)`)
+
+// datasetPolicy is a UDF applied to a dataset as a row access or masking policy
+type datasetPolicy struct {
+	Function  []string // Path of the UDF
+	Arguments []string // Column names passed to the UDF
+}
+
+// sql returns the policy as a function call, e.g. "space"."mask_ssn"("ssn")
+func (p datasetPolicy) sql() string {
+	arguments := make([]string, len(p.Arguments))
+	for i, argument := range p.Arguments {
+		arguments[i] = quoteSQLIdentifier(argument)
+	}
+	return fmt.Sprintf("%s(%s)", quoteSQLPath(p.Function), strings.Join(arguments, ", "))
+}
+
+// equalFold reports whether two policies call the same UDF with the same columns, ignoring case,
+// since Dremio identifiers are case-insensitive
+func (p datasetPolicy) equalFold(other datasetPolicy) bool {
+	if len(p.Function) != len(other.Function) || len(p.Arguments) != len(other.Arguments) {
+		return false
+	}
+	for i := range p.Function {
+		if !strings.EqualFold(p.Function[i], other.Function[i]) {
+			return false
+		}
+	}
+	for i := range p.Arguments {
+		if !strings.EqualFold(p.Arguments[i], other.Arguments[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// getPolicyDataset reads the catalog entry of the table or view a policy applies to
+func getPolicyDataset(client *dremioClient.Client, projectID, datasetID string) (*models.TableResponse, error) {
+	api_resp, err := client.RequestToDremioInProject(projectID, "GET", fmt.Sprintf("/catalog/%s", datasetID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer api_resp.Body.Close()
+
+	var dataset models.TableResponse
+	if err := json.NewDecoder(api_resp.Body).Decode(&dataset); err != nil {
+		return nil, fmt.Errorf("unable to parse catalog response: %w", err)
+	}
+	if dataset.Type != "PHYSICAL_DATASET" && dataset.Type != "VIRTUAL_DATASET" {
+		return nil, fmt.Errorf("catalog object %s is not a table or a view", datasetID)
+	}
+	return &dataset, nil
+}
+
+// datasetKeyword returns the SQL keyword of a dataset type, TABLE or VIEW
+func datasetKeyword(dataset *models.TableResponse) string {
+	if dataset.Type == "VIRTUAL_DATASET" {
+		return "VIEW"
+	}
+	return "TABLE"
+}
+
+// showCreateDataset returns the definition of a table or view, including its policies
+func showCreateDataset(ctx context.Context, client *dremioClient.Client, projectID string, dataset *models.TableResponse) (string, error) {
+	sqlReq := models.SQLRequest{SQL: fmt.Sprintf("SHOW CREATE %s %s", datasetKeyword(dataset), quoteSQLPath(dataset.Path))}
+	jobID, err := runSQLJob(ctx, client, projectID, sqlReq, policyStatementTimeout)
+	if err != nil {
+		return "", err
+	}
+
+	results, err := client.GetJobResults(projectID, jobID, 1)
+	if err != nil {
+		return "", err
+	}
+	if len(results.Rows) == 0 {
+		return "", fmt.Errorf("SHOW CREATE %s returned no rows", datasetKeyword(dataset))
+	}
+
+	// The result has the path and the definition of the dataset, keep the definition
+	definition := ""
+	for _, value := range results.Rows[0] {
+		if s, ok := value.(string); ok && len(s) > len(definition) {
+			definition = s
+		}
+	}
+	return definition, nil
+}
+
+// parseRowAccessPolicy returns the row access policy of a dataset definition, or nil when it has none
+func parseRowAccessPolicy(definition string) *datasetPolicy {
+	match := rowAccessPolicyPattern.FindStringSubmatch(definition)
+	if match == nil {
+		return nil
+	}
+	return &datasetPolicy{
+		Function:  splitSQLList(match[1], '.'),
+		Arguments: splitSQLList(match[2], ','),
+	}
+}
+
+// parseMaskingPolicy returns the masking policy of a column in a dataset definition, or nil when it has none
+func parseMaskingPolicy(definition, column string) *datasetPolicy {
+	// The column name, then its type, which may hold parentheses such as DECIMAL(10, 2)
+	pattern := regexp.MustCompile(`(?is)(?:^|[\s,(])(?:` + regexp.QuoteMeta(quoteSQLIdentifier(column)) + `|` + regexp.QuoteMeta(column) + `)\s+(?:[^,()]|\([^)]*\))*?\bMASKING\s+POLICY\s+([^(]+?)\s*\(([^)]*)\)`)
+	match := pattern.FindStringSubmatch(definition)
+	if match == nil {
+		return nil
+	}
+	return &datasetPolicy{
+		Function:  splitSQLList(match[1], '.'),
+		Arguments: splitSQLList(match[2], ','),
+	}
+}
+
+// splitSQLList splits a list of SQL identifiers on a separator outside double quotes, and unquotes them
+func splitSQLList(list string, separator rune) []string {
+	elements := []string{}
+	var current strings.Builder
+	quoted := false
+	runes := []rune(list)
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; {
+		case c == '"' && quoted && i+1 < len(runes) && runes[i+1] == '"':
+			// Escaped double quote inside a quoted identifier
+			current.WriteRune('"')
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == separator && !quoted:
+			elements = append(elements, strings.TrimSpace(current.String()))
+			current.Reset()
+		default:
+			current.WriteRune(c)
+		}
+	}
+	if last := strings.TrimSpace(current.String()); last != "" || len(elements) > 0 {
+		elements = append(elements, last)
+	}
+	return elements
+}
@@ -0,0 +1,260 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioArcticCatalog{}
+	_ resource.ResourceWithConfigure   = &dremioArcticCatalog{}
+	_ resource.ResourceWithImportState = &dremioArcticCatalog{}
+)
+
+type dremioArcticCatalog struct {
+	client *dremioClient.Client
+}
+
+func NewDremioArcticCatalogResource() resource.Resource {
+	return &dremioArcticCatalog{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioArcticCatalog) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_arctic_catalog"
+}
+
+func (r *dremioArcticCatalog) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_arctic_catalog"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioArcticCatalog) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dremioArcticCatalog) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Arctic Catalog resource - manages an Arctic catalog of the Dremio Cloud organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the catalog",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the catalog. Must be unique within the organization. Changing it creates a new catalog.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user who owns the catalog",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user who owns the catalog",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the catalog was created (UTC)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioArcticCatalog) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioArcticCatalogModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.ArcticCatalogCreateRequest{
+		Name: data.Name.ValueString(),
+		// Generate a unique request ID for idempotency
+		RequestID: uuid.New().String(),
+	}
+
+	// Arctic catalogs are organization-wide, so they use the global endpoint
+	api_resp, err := r.client.RequestToDremio("POST", "/arctic/catalogs", reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create Arctic catalog, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	catalogResp := r.parseArcticCatalogResponse(api_resp.Body, &resp.Diagnostics)
+	if catalogResp == nil {
+		return
+	}
+
+	r.fromResponseToState(catalogResp, &data)
+
+	tflog.Trace(ctx, "created an Arctic catalog resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioArcticCatalog) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioArcticCatalogModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/arctic/catalogs/%s", id), nil, true)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Arctic catalog %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read Arctic catalog, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	catalogResp := r.parseArcticCatalogResponse(api_resp.Body, &resp.Diagnostics)
+	if catalogResp == nil {
+		return
+	}
+
+	state.Name = types.StringValue(catalogResp.Name)
+	r.fromResponseToState(catalogResp, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called with changes to the catalog, since its name forces a new resource.
+func (r *dremioArcticCatalog) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioArcticCatalogModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioArcticCatalog) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioArcticCatalogModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/arctic/catalogs/%s", id), nil, true)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Arctic catalog %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete Arctic catalog, got error: %s", err),
+		)
+		return
+	}
+}
+
+// parseArcticCatalogResponse reads and decodes an Arctic catalog from an API response body
+func (r *dremioArcticCatalog) parseArcticCatalogResponse(body io.Reader, diags *diag.Diagnostics) *models.ArcticCatalogResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var catalogResp models.ArcticCatalogResponse
+	if err := json.Unmarshal(resp_body, &catalogResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &catalogResp
+}
+
+func (r *dremioArcticCatalog) fromResponseToState(catalogResp *models.ArcticCatalogResponse, state *models.DremioArcticCatalogModel) {
+	state.ID = types.StringValue(catalogResp.ID)
+	state.OwnerID = types.StringValue(catalogResp.OwnerID)
+	state.OwnerName = types.StringValue(catalogResp.OwnerName)
+	state.CreatedAt = types.StringValue(catalogResp.CreatedAt)
+}
@@ -0,0 +1,376 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioArcticSchedule{}
+	_ resource.ResourceWithConfigure   = &dremioArcticSchedule{}
+	_ resource.ResourceWithImportState = &dremioArcticSchedule{}
+)
+
+type dremioArcticSchedule struct {
+	client *dremioClient.Client
+}
+
+func NewDremioArcticScheduleResource() resource.Resource {
+	return &dremioArcticSchedule{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioArcticSchedule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_arctic_schedule"
+}
+
+func (r *dremioArcticSchedule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_arctic_schedule"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioArcticSchedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Schedules belong to a catalog, so the import ID holds both IDs
+	catalogID, id, found := strings.Cut(req.ID, "/")
+	if !found || catalogID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <catalog_id>/<schedule_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("catalog_id"), catalogID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *dremioArcticSchedule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Arctic Schedule resource - runs an OPTIMIZE or VACUUM task on the tables of an Arctic catalog on a cron schedule.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the schedule",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"catalog_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Arctic catalog the schedule runs on",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the schedule",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cron_expression": schema.StringAttribute{
+				MarkdownDescription: "Cron expression of the schedule, evaluated in UTC, e.g. `0 0 2 * * ?`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"task_type": schema.StringAttribute{
+				MarkdownDescription: "Task the schedule runs (OPTIMIZE or VACUUM). Changing it creates a new schedule.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("OPTIMIZE", "VACUUM"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.StringAttribute{
+				MarkdownDescription: "Task-specific parameters as a JSON string, e.g. `jsonencode({ retentionPeriodMinutes = 4320 })` for VACUUM",
+				Optional:            true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the schedule runs. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the schedule was created (UTC)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the schedule was last modified (UTC)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioArcticSchedule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioArcticScheduleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := r.parametersFromModel(&data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.ArcticScheduleCreateRequest{
+		Name:           data.Name.ValueString(),
+		CronExpression: data.CronExpression.ValueString(),
+		Enabled:        data.Enabled.ValueBoolPointer(),
+		TaskType:       data.TaskType.ValueString(),
+		Parameters:     parameters,
+		// Generate a unique request ID for idempotency
+		RequestID: uuid.New().String(),
+	}
+
+	api_resp, err := r.client.RequestToDremio("POST", fmt.Sprintf("/arctic/catalogs/%s/schedules", data.CatalogID.ValueString()), reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create Arctic schedule, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	scheduleResp := r.parseArcticScheduleResponse(api_resp.Body, &resp.Diagnostics)
+	if scheduleResp == nil {
+		return
+	}
+
+	r.fromResponseToState(scheduleResp, &data)
+
+	tflog.Trace(ctx, "created an Arctic schedule resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioArcticSchedule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioArcticScheduleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	api_resp, err := r.client.RequestToDremio("GET", r.schedulePath(&state), nil, true)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Arctic schedule %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read Arctic schedule, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	scheduleResp := r.parseArcticScheduleResponse(api_resp.Body, &resp.Diagnostics)
+	if scheduleResp == nil {
+		return
+	}
+
+	// Refresh user-managed fields to detect changes made outside of Terraform
+	state.Name = types.StringValue(scheduleResp.Name)
+	state.CronExpression = types.StringValue(scheduleResp.CronExpression)
+	state.TaskType = types.StringValue(scheduleResp.TaskType)
+	state.Enabled = types.BoolValue(scheduleResp.Enabled)
+	if len(scheduleResp.Parameters) == 0 {
+		state.Parameters = jsontypes.NewNormalizedNull()
+	} else {
+		parametersBytes, err := json.Marshal(scheduleResp.Parameters)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"JSON Conversion Error",
+				fmt.Sprintf("Unable to marshal schedule parameters: %s", err),
+			)
+			return
+		}
+		// Normalized JSON keeps the configured formatting when the parameters are semantically equal
+		state.Parameters = jsontypes.NewNormalizedValue(string(parametersBytes))
+	}
+	r.fromResponseToState(scheduleResp, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioArcticSchedule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioArcticScheduleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := r.parametersFromModel(&plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// An empty map removes parameters that are no longer configured
+	if parameters == nil {
+		parameters = map[string]interface{}{}
+	}
+
+	reqBody := &models.ArcticScheduleUpdateRequest{
+		Name:           plan.Name.ValueString(),
+		CronExpression: plan.CronExpression.ValueString(),
+		Enabled:        plan.Enabled.ValueBoolPointer(),
+		Parameters:     parameters,
+	}
+
+	api_resp, err := r.client.RequestToDremio("PUT", r.schedulePath(&plan), reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update Arctic schedule, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	scheduleResp := r.parseArcticScheduleResponse(api_resp.Body, &resp.Diagnostics)
+	if scheduleResp == nil {
+		return
+	}
+
+	r.fromResponseToState(scheduleResp, &plan)
+
+	tflog.Trace(ctx, "updated an Arctic schedule resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioArcticSchedule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioArcticScheduleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremio("DELETE", r.schedulePath(&state), nil, true)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Arctic schedule %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete Arctic schedule, got error: %s", err),
+		)
+		return
+	}
+}
+
+// schedulePath returns the API path of a schedule
+func (r *dremioArcticSchedule) schedulePath(data *models.DremioArcticScheduleModel) string {
+	return fmt.Sprintf("/arctic/catalogs/%s/schedules/%s", data.CatalogID.ValueString(), data.ID.ValueString())
+}
+
+// parametersFromModel decodes the parameters JSON of the Terraform model, or returns nil when it is not set
+func (r *dremioArcticSchedule) parametersFromModel(data *models.DremioArcticScheduleModel, diags *diag.Diagnostics) map[string]interface{} {
+	if data.Parameters.IsNull() || data.Parameters.IsUnknown() {
+		return nil
+	}
+
+	var parameters map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Parameters.ValueString()), &parameters); err != nil {
+		diags.AddAttributeError(
+			path.Root("parameters"),
+			"Invalid Parameters",
+			fmt.Sprintf("Schedule parameters must be a JSON object: %s", err),
+		)
+		return nil
+	}
+	return parameters
+}
+
+// parseArcticScheduleResponse reads and decodes an Arctic schedule from an API response body
+func (r *dremioArcticSchedule) parseArcticScheduleResponse(body io.Reader, diags *diag.Diagnostics) *models.ArcticScheduleResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var scheduleResp models.ArcticScheduleResponse
+	if err := json.Unmarshal(resp_body, &scheduleResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &scheduleResp
+}
+
+func (r *dremioArcticSchedule) fromResponseToState(scheduleResp *models.ArcticScheduleResponse, state *models.DremioArcticScheduleModel) {
+	state.ID = types.StringValue(scheduleResp.ID)
+	state.CreatedAt = types.StringValue(scheduleResp.CreatedAt)
+	state.ModifiedAt = types.StringValue(scheduleResp.ModifiedAt)
+}
@@ -0,0 +1,293 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioBillingAccount{}
+	_ resource.ResourceWithConfigure   = &dremioBillingAccount{}
+	_ resource.ResourceWithImportState = &dremioBillingAccount{}
+)
+
+type dremioBillingAccount struct {
+	client *dremioClient.Client
+}
+
+func NewDremioBillingAccountResource() resource.Resource {
+	return &dremioBillingAccount{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioBillingAccount) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_billing_account"
+}
+
+func (r *dremioBillingAccount) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_billing_account"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioBillingAccount) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dremioBillingAccount) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Billing Account resource - manages the name, description and metadata of an existing billing account. " +
+			"Billing accounts are created with the organization, so the resource adopts an existing account and destroying it only removes it from the Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the existing billing account. Changing it manages another billing account.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the billing account",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the billing account",
+				Optional:            true,
+			},
+			"metadata": schema.StringAttribute{
+				MarkdownDescription: "Additional metadata as a JSON string, e.g. `jsonencode({ cost_center = \"CC-1234\" })`",
+				Optional:            true,
+				CustomType:          jsontypes.NormalizedType{},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the billing account was created (UTC)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the billing account was last modified (UTC)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Create adopts the existing billing account and applies the configured settings.
+func (r *dremioBillingAccount) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioBillingAccountModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountResp := r.updateBillingAccount(&data, &resp.Diagnostics)
+	if accountResp == nil {
+		return
+	}
+
+	r.fromResponseToState(accountResp, &data)
+
+	tflog.Trace(ctx, "created a billing account resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioBillingAccount) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioBillingAccountModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	// Billing accounts are organization-wide, so they use the global endpoint
+	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/billing-accounts/%s", id), nil, true)
+	if err != nil {
+		// If resource is not found (404), remove it from state
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Billing account %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read billing account, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	accountResp := r.parseBillingAccountResponse(api_resp.Body, &resp.Diagnostics)
+	if accountResp == nil {
+		return
+	}
+
+	// Refresh user-managed fields to detect changes made outside of Terraform
+	state.Name = types.StringValue(accountResp.Name)
+	if accountResp.Description != "" || !state.Description.IsNull() {
+		state.Description = types.StringValue(accountResp.Description)
+	}
+	if len(accountResp.Metadata) == 0 {
+		state.Metadata = jsontypes.NewNormalizedNull()
+	} else {
+		metadataBytes, err := json.Marshal(accountResp.Metadata)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"JSON Conversion Error",
+				fmt.Sprintf("Unable to marshal billing account metadata: %s", err),
+			)
+			return
+		}
+		// Normalized JSON keeps the configured formatting when the metadata is semantically equal
+		state.Metadata = jsontypes.NewNormalizedValue(string(metadataBytes))
+	}
+	r.fromResponseToState(accountResp, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioBillingAccount) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioBillingAccountModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountResp := r.updateBillingAccount(&plan, &resp.Diagnostics)
+	if accountResp == nil {
+		return
+	}
+
+	r.fromResponseToState(accountResp, &plan)
+
+	tflog.Trace(ctx, "updated a billing account resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the billing account from the Terraform state, since billing accounts cannot be deleted.
+func (r *dremioBillingAccount) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioBillingAccountModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("Billing account %s cannot be deleted, only removing it from state", state.ID.ValueString()))
+}
+
+// updateBillingAccount sends the configured settings of the billing account and returns the updated account
+func (r *dremioBillingAccount) updateBillingAccount(data *models.DremioBillingAccountModel, diags *diag.Diagnostics) *models.BillingAccountResponse {
+	// An empty map removes metadata that is no longer configured
+	metadata := map[string]interface{}{}
+	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
+		if err := json.Unmarshal([]byte(data.Metadata.ValueString()), &metadata); err != nil {
+			diags.AddAttributeError(
+				path.Root("metadata"),
+				"Invalid Metadata",
+				fmt.Sprintf("Billing account metadata must be a JSON object: %s", err),
+			)
+			return nil
+		}
+	}
+
+	reqBody := &models.BillingAccountUpdateRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Metadata:    metadata,
+	}
+
+	id := data.ID.ValueString()
+	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/billing-accounts/%s", id), reqBody, true)
+	if err != nil {
+		diags.AddError(
+			"Client Error", fmt.Sprintf("Unable to update billing account %s, got error: %s", id, err),
+		)
+		return nil
+	}
+	defer api_resp.Body.Close()
+
+	return r.parseBillingAccountResponse(api_resp.Body, diags)
+}
+
+// parseBillingAccountResponse reads and decodes a billing account from an API response body
+func (r *dremioBillingAccount) parseBillingAccountResponse(body io.Reader, diags *diag.Diagnostics) *models.BillingAccountResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var accountResp models.BillingAccountResponse
+	if err := json.Unmarshal(resp_body, &accountResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &accountResp
+}
+
+func (r *dremioBillingAccount) fromResponseToState(accountResp *models.BillingAccountResponse, state *models.DremioBillingAccountModel) {
+	state.CreatedAt = types.StringValue(accountResp.CreatedAt)
+	state.ModifiedAt = types.StringValue(accountResp.ModifiedAt)
+}
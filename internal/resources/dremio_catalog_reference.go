@@ -0,0 +1,326 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioCatalogReference{}
+	_ resource.ResourceWithConfigure   = &dremioCatalogReference{}
+	_ resource.ResourceWithImportState = &dremioCatalogReference{}
+)
+
+const (
+	// catalogReferenceStatementTimeout is how long the branch and tag statements may run
+	catalogReferenceStatementTimeout = 5 * time.Minute
+	// catalogReferenceMaxRows is the maximum number of branches or tags read from a source
+	catalogReferenceMaxRows = 10000
+)
+
+// dremioCatalogReference manages a branch or a tag of a versioned source (Arctic or Nessie) with SQL,
+// since the catalog API has no endpoint for references
+type dremioCatalogReference struct {
+	client  *dremioClient.Client
+	refType string // BRANCH or TAG
+}
+
+func NewDremioCatalogBranchResource() resource.Resource {
+	return &dremioCatalogReference{refType: "BRANCH"}
+}
+
+func NewDremioCatalogTagResource() resource.Resource {
+	return &dremioCatalogReference{refType: "TAG"}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioCatalogReference) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_" + r.kind()
+}
+
+func (r *dremioCatalogReference) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioCatalogReference) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is <source>:<name>, optionally prefixed with <project_id>/.
+	// Reference names may contain slashes (e.g. feature/x) but not colons, and source names contain neither.
+	sourcePart, name, found := strings.Cut(req.ID, ":")
+	projectID, source, hasProject := strings.Cut(sourcePart, "/")
+	if !hasProject {
+		projectID, source = "", sourcePart
+	}
+	if !found || source == "" || name == "" || (hasProject && projectID == "") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <source>:<name> or <project_id>/<source>:<name>, got: %q", req.ID),
+		)
+		return
+	}
+
+	if hasProject {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source"), source)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// Schema defines the schema for the resource.
+func (r *dremioCatalogReference) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	kind := r.kind()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Dremio Catalog %s resource - manages a %s of a versioned source (Arctic, or Nessie on Dremio Software). ", strings.ToUpper(kind[:1])+kind[1:], kind) +
+			"Without `from_branch` or `from_hash`, it is created at the head of the default branch of the source.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("ID of the %s, in the form `<source>:<name>`", kind),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Name of the versioned source",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Name of the %s", kind),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_branch": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Branch whose head the %s is created at", kind),
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("from_hash")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_hash": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Commit hash the %s is created at", kind),
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Commit hash the %s currently points to", kind),
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioCatalogReference) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioCatalogReferenceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statement := fmt.Sprintf("CREATE %s %s", r.refType, quoteSQLIdentifier(data.Name.ValueString()))
+	if !data.FromHash.IsNull() {
+		statement += fmt.Sprintf(" AT COMMIT %s", quoteSQLIdentifier(data.FromHash.ValueString()))
+	} else if !data.FromBranch.IsNull() {
+		statement += fmt.Sprintf(" AT BRANCH %s", quoteSQLIdentifier(data.FromBranch.ValueString()))
+	}
+	statement += fmt.Sprintf(" IN %s", quoteSQLIdentifier(data.Source.ValueString()))
+
+	projectID := data.ProjectID.ValueString()
+	sqlReq := models.SQLRequest{SQL: statement}
+	if _, err := runSQLJob(ctx, r.client, projectID, sqlReq, catalogReferenceStatementTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create %s, got error: %s", r.kind(), err),
+		)
+		return
+	}
+
+	hash, found, err := r.readHash(ctx, projectID, data.Source.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read %s, got error: %s", r.kind(), err),
+		)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("The %s %s was created but is not listed in source %s", r.kind(), data.Name.ValueString(), data.Source.ValueString()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(catalogReferenceID(data.Source.ValueString(), data.Name.ValueString()))
+	data.Hash = types.StringValue(hash)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a catalog %s resource", r.kind()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioCatalogReference) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioCatalogReferenceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source := state.Source.ValueString()
+	name := state.Name.ValueString()
+	hash, found, err := r.readHash(ctx, state.ProjectID.ValueString(), source, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read %s, got error: %s", r.kind(), err),
+		)
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("The %s %s not found in source %s, removing from state", r.kind(), name, source))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(catalogReferenceID(source, name))
+	state.Hash = types.StringValue(hash)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called with changes to the reference, since every argument forces a new resource.
+func (r *dremioCatalogReference) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioCatalogReferenceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.DremioCatalogReferenceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Hash = state.Hash
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioCatalogReference) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioCatalogReferenceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// FORCE drops the reference even when commits moved it since the last read
+	statement := fmt.Sprintf("DROP %s IF EXISTS %s FORCE IN %s", r.refType, quoteSQLIdentifier(state.Name.ValueString()), quoteSQLIdentifier(state.Source.ValueString()))
+	sqlReq := models.SQLRequest{SQL: statement}
+	if _, err := runSQLJob(ctx, r.client, state.ProjectID.ValueString(), sqlReq, catalogReferenceStatementTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to drop %s, got error: %s", r.kind(), err),
+		)
+		return
+	}
+}
+
+// readHash lists the references of the source and returns the commit hash of the named one,
+// and whether it exists
+func (r *dremioCatalogReference) readHash(ctx context.Context, projectID, source, name string) (string, bool, error) {
+	sqlReq := models.SQLRequest{SQL: fmt.Sprintf("SHOW %sS IN %s", r.refType, quoteSQLIdentifier(source))}
+	jobID, err := runSQLJob(ctx, r.client, projectID, sqlReq, catalogReferenceStatementTimeout)
+	if err != nil {
+		return "", false, err
+	}
+
+	results, err := r.client.GetJobResults(projectID, jobID, catalogReferenceMaxRows)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, row := range results.Rows {
+		if refName, _ := row["refName"].(string); refName == name {
+			hash, _ := row["commitHash"].(string)
+			return hash, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// kind returns the lower-case name of the reference type, branch or tag
+func (r *dremioCatalogReference) kind() string {
+	return strings.ToLower(r.refType)
+}
+
+// catalogRefQuery returns the query string that targets a branch of a versioned source in catalog API calls,
+// or an empty string when no branch is set
+func catalogRefQuery(ref types.String) string {
+	if ref.IsNull() || ref.IsUnknown() || ref.ValueString() == "" {
+		return ""
+	}
+	return "?versionType=BRANCH&versionValue=" + url.QueryEscape(ref.ValueString())
+}
+
+// catalogReferenceID returns the ID of a branch or tag, which is also its import ID.
+// The name is separated with a colon, since reference names may contain slashes.
+func catalogReferenceID(source, name string) string {
+	return source + ":" + name
+}
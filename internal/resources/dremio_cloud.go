@@ -0,0 +1,505 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioCloud{}
+	_ resource.ResourceWithConfigure   = &dremioCloud{}
+	_ resource.ResourceWithImportState = &dremioCloud{}
+)
+
+const (
+	// cloudPollInterval is the time between two reads of the cloud state
+	cloudPollInterval = 10 * time.Second
+	// cloudPollTimeout is the maximum time to wait for a cloud to become ACTIVE or to be deleted
+	cloudPollTimeout = 30 * time.Minute
+)
+
+type dremioCloud struct {
+	client *dremioClient.Client
+}
+
+func NewDremioCloudResource() resource.Resource {
+	return &dremioCloud{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioCloud) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud"
+}
+
+func (r *dremioCloud) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_cloud"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioCloud) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dremioCloud) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Cloud resource - manages the AWS or Azure compute environment where the engines of projects run. Creation waits until the cloud is ACTIVE.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the cloud",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cloud",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"aws": schema.SingleNestedAttribute{
+				MarkdownDescription: "Network and IAM settings of an AWS cloud. Exactly one of `aws` or `azure` must be set. Changing them forces a new resource.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("azure")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						MarkdownDescription: "AWS region of the VPC, e.g. `us-west-2`",
+						Required:            true,
+					},
+					"vpc_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the VPC where engines run",
+						Required:            true,
+					},
+					"subnet_ids": schema.ListAttribute{
+						MarkdownDescription: "IDs of the subnets of the VPC where engines run",
+						Required:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"role_arn": schema.StringAttribute{
+						MarkdownDescription: "ARN of the cross-account IAM role Dremio assumes to manage compute resources",
+						Required:            true,
+					},
+					"instance_profile_arn": schema.StringAttribute{
+						MarkdownDescription: "ARN of the instance profile attached to the engine instances",
+						Required:            true,
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				MarkdownDescription: "Network and identity settings of an Azure cloud. Exactly one of `aws` or `azure` must be set. Changing them forces a new resource.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"tenant_id": schema.StringAttribute{
+						MarkdownDescription: "Microsoft Entra tenant ID",
+						Required:            true,
+					},
+					"subscription_id": schema.StringAttribute{
+						MarkdownDescription: "Azure subscription ID",
+						Required:            true,
+					},
+					"resource_group": schema.StringAttribute{
+						MarkdownDescription: "Resource group where engines run",
+						Required:            true,
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Azure region of the virtual network, e.g. `eastus`",
+						Required:            true,
+					},
+					"vnet_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the virtual network where engines run",
+						Required:            true,
+					},
+					"subnet_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the subnet of the virtual network where engines run",
+						Required:            true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Application (client) ID Dremio uses to manage compute resources",
+						Required:            true,
+					},
+				},
+			},
+			"cloud_tags": schema.ListNestedAttribute{
+				MarkdownDescription: "Tags applied to the compute resources Dremio creates in the cloud",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Key of the tag",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of the tag",
+							Required:            true,
+						},
+					},
+				},
+			},
+			// Left unknown on update, since the PUT response can report a transitional state such as UPDATING
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the cloud (e.g. PROVISIONING, ACTIVE, FAILED)",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the cloud was created (UTC)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioCloud) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioCloudModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, diags := helpers.ConvertCloudAttributesFromTerraform(ctx, data.AWS, data.Azure, data.CloudTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.CloudCreateRequest{
+		Name:       data.Name.ValueString(),
+		Attributes: attributes,
+		// Generate a unique request ID for idempotency
+		RequestID: uuid.New().String(),
+	}
+
+	// Clouds are organization-wide, so they use the global endpoint
+	api_resp, err := r.client.RequestToDremio("POST", "/clouds", reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create cloud, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	cloudResp := r.parseCloudResponse(api_resp.Body, &resp.Diagnostics)
+	if cloudResp == nil {
+		return
+	}
+
+	// Save the ID right away, so that a cloud that fails to become ACTIVE is still tracked
+	data.ID = types.StringValue(cloudResp.ID)
+	r.fromResponseToState(cloudResp, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for cloud %s to become ACTIVE", cloudResp.ID))
+
+	cloudResp, err = r.waitForActive(ctx, cloudResp.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Cloud %s was created but did not become ACTIVE: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	r.fromResponseToState(cloudResp, &data)
+
+	tflog.Trace(ctx, "created a cloud resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioCloud) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioCloudModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	cloudResp, err := r.getCloud(id)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Cloud %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read cloud, got error: %s", err),
+		)
+		return
+	}
+
+	// Refresh user-managed fields to detect changes made outside of Terraform
+	state.Name = types.StringValue(cloudResp.Name)
+	if cloudResp.Attributes != nil {
+		aws, diags := helpers.ConvertCloudAWSPropsToTerraform(ctx, cloudResp.Attributes.AWSProps)
+		resp.Diagnostics.Append(diags...)
+		azure, diags := helpers.ConvertCloudAzurePropsToTerraform(ctx, cloudResp.Attributes.AzureProps)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.AWS = aws
+		state.Azure = azure
+
+		// Keep cloud_tags null when it is not configured and the cloud has no tags
+		if len(cloudResp.Attributes.CloudTags) > 0 || !state.CloudTags.IsNull() {
+			cloudTags, diags := helpers.ConvertCloudTagsToTerraform(ctx, cloudResp.Attributes.CloudTags)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			state.CloudTags = cloudTags
+		}
+	}
+	r.fromResponseToState(cloudResp, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioCloud) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioCloudModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, diags := helpers.ConvertCloudAttributesFromTerraform(ctx, plan.AWS, plan.Azure, plan.CloudTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueString()
+	reqBody := &models.CloudUpdateRequest{
+		Name:       plan.Name.ValueString(),
+		Attributes: attributes,
+	}
+
+	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/clouds/%s", id), reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update cloud, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	cloudResp := r.parseCloudResponse(api_resp.Body, &resp.Diagnostics)
+	if cloudResp == nil {
+		return
+	}
+
+	r.fromResponseToState(cloudResp, &plan)
+
+	tflog.Trace(ctx, "updated a cloud resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioCloud) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioCloudModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/clouds/%s", id), nil, true)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Cloud %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete cloud, got error: %s", err),
+		)
+		return
+	}
+
+	// Deletion is asynchronous, wait until the cloud is gone
+	if err := r.waitForDeletion(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Cloud %s was not deleted: %s", id, err),
+		)
+		return
+	}
+}
+
+// getCloud reads a cloud by ID
+func (r *dremioCloud) getCloud(id string) (*models.CloudResponse, error) {
+	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/clouds/%s", id), nil, true)
+	if err != nil {
+		return nil, err
+	}
+	defer api_resp.Body.Close()
+
+	resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body: %w", err)
+	}
+
+	var cloudResp models.CloudResponse
+	if err := json.Unmarshal(resp_body, &cloudResp); err != nil {
+		return nil, fmt.Errorf("unable to parse response: %w", err)
+	}
+	return &cloudResp, nil
+}
+
+// waitForActive polls the cloud until it is ACTIVE, it lands in a state it cannot leave by itself, or the timeout expires
+func (r *dremioCloud) waitForActive(ctx context.Context, id string) (*models.CloudResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, cloudPollTimeout)
+	defer cancel()
+
+	for {
+		cloudResp, err := r.getCloud(id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch cloudResp.State {
+		case "ACTIVE":
+			return cloudResp, nil
+		case "FAILED", "INACTIVE":
+			if cloudResp.LastStateError != nil && cloudResp.LastStateError.Error != "" {
+				return nil, fmt.Errorf("cloud is %s: %s", cloudResp.State, cloudResp.LastStateError.Error)
+			}
+			return nil, fmt.Errorf("cloud is %s", cloudResp.State)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Cloud %s is %s, waiting", id, cloudResp.State))
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out after %s, last state was %s", cloudPollTimeout, cloudResp.State)
+		case <-time.After(cloudPollInterval):
+		}
+	}
+}
+
+// waitForDeletion polls the cloud until Dremio no longer finds it, or the timeout expires
+func (r *dremioCloud) waitForDeletion(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, cloudPollTimeout)
+	defer cancel()
+
+	for {
+		cloudResp, err := r.getCloud(id)
+		if err != nil {
+			if dremioClient.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Cloud %s is %s, waiting for deletion", id, cloudResp.State))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s, last state was %s", cloudPollTimeout, cloudResp.State)
+		case <-time.After(cloudPollInterval):
+		}
+	}
+}
+
+// parseCloudResponse reads and decodes a cloud from an API response body
+func (r *dremioCloud) parseCloudResponse(body io.Reader, diags *diag.Diagnostics) *models.CloudResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var cloudResp models.CloudResponse
+	if err := json.Unmarshal(resp_body, &cloudResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &cloudResp
+}
+
+func (r *dremioCloud) fromResponseToState(cloudResp *models.CloudResponse, state *models.DremioCloudModel) {
+	state.ID = types.StringValue(cloudResp.ID)
+	state.State = types.StringValue(cloudResp.State)
+	state.CreatedAt = types.StringValue(cloudResp.CreatedAt)
+}
@@ -0,0 +1,348 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioColumnMaskingPolicy{}
+	_ resource.ResourceWithConfigure   = &dremioColumnMaskingPolicy{}
+	_ resource.ResourceWithImportState = &dremioColumnMaskingPolicy{}
+)
+
+type dremioColumnMaskingPolicy struct {
+	client *dremioClient.Client
+}
+
+func NewDremioColumnMaskingPolicyResource() resource.Resource {
+	return &dremioColumnMaskingPolicy{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioColumnMaskingPolicy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_column_masking_policy"
+}
+
+func (r *dremioColumnMaskingPolicy) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioColumnMaskingPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is <dataset_id>:<column>, optionally prefixed with <project_id>/.
+	// Everything after the first colon is the column, since quoted column names may contain any character.
+	datasetPart, column, found := strings.Cut(req.ID, ":")
+	projectID, datasetID, hasProject := strings.Cut(datasetPart, "/")
+	if !hasProject {
+		projectID, datasetID = "", datasetPart
+	}
+	if !found || datasetID == "" || column == "" || (hasProject && projectID == "") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <dataset_id>:<column> or <project_id>/<dataset_id>:<column>, got: %q", req.ID),
+		)
+		return
+	}
+
+	if hasProject {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset_id"), datasetID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("column"), column)...)
+}
+
+// Schema defines the schema for the resource.
+func (r *dremioColumnMaskingPolicy) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Column Masking Policy resource - masks the values of a column of a table or view with a UDF. " +
+			"The UDF must return the same type as the column. A column has at most one masking policy.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the policy, in the form `<dataset_id>:<column>`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the table or view the policy applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column": schema.StringAttribute{
+				MarkdownDescription: "Name of the column to mask",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"udf_path": schema.ListAttribute{
+				MarkdownDescription: "Path of the UDF that returns the masked value, e.g. `dremio_udf.mask_ssn.path`",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"arguments": schema.ListAttribute{
+				MarkdownDescription: "Columns of the dataset passed to the UDF, in the order of its arguments. Defaults to the masked column alone.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_path": schema.ListAttribute{
+				MarkdownDescription: "Path of the table or view the policy applies to",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioColumnMaskingPolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioColumnMaskingPolicyModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Arguments.IsNull() || data.Arguments.IsUnknown() {
+		arguments, diags := types.ListValueFrom(ctx, types.StringType, []string{data.Column.ValueString()})
+		resp.Diagnostics.Append(diags...)
+		data.Arguments = arguments
+	}
+
+	r.setPolicy(ctx, &data, "Unable to set column masking policy", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a column masking policy resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioColumnMaskingPolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioColumnMaskingPolicyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	datasetID := state.DatasetID.ValueString()
+	column := state.Column.ValueString()
+	dataset, err := getPolicyDataset(r.client, projectID, datasetID)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Dataset %s not found, removing column masking policy from state", datasetID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read the dataset of the column masking policy, got error: %s", err),
+		)
+		return
+	}
+
+	definition, err := showCreateDataset(ctx, r.client, projectID, dataset)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read the definition of dataset %s, got error: %s", datasetID, err),
+		)
+		return
+	}
+
+	actual := parseMaskingPolicy(definition, column)
+	if actual == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Column %s of dataset %s has no masking policy, removing from state", column, datasetID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Keep the configured spelling when Dremio reports the same policy, so that case changes are not drift
+	if state.UDFPath.IsNull() || state.Arguments.IsNull() || !r.policyFromModel(ctx, &state, &resp.Diagnostics).equalFold(*actual) {
+		udfPath, diags := types.ListValueFrom(ctx, types.StringType, actual.Function)
+		resp.Diagnostics.Append(diags...)
+		arguments, diags := types.ListValueFrom(ctx, types.StringType, actual.Arguments)
+		resp.Diagnostics.Append(diags...)
+		state.UDFPath = udfPath
+		state.Arguments = arguments
+	}
+
+	state.ID = types.StringValue(columnMaskingPolicyID(datasetID, column))
+	datasetPath, diags := types.ListValueFrom(ctx, types.StringType, dataset.Path)
+	resp.Diagnostics.Append(diags...)
+	state.DatasetPath = datasetPath
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioColumnMaskingPolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioColumnMaskingPolicyModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Arguments.IsNull() || plan.Arguments.IsUnknown() {
+		arguments, diags := types.ListValueFrom(ctx, types.StringType, []string{plan.Column.ValueString()})
+		resp.Diagnostics.Append(diags...)
+		plan.Arguments = arguments
+	}
+
+	// Setting a masking policy replaces the previous policy of the column
+	r.setPolicy(ctx, &plan, "Unable to update column masking policy", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a column masking policy resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioColumnMaskingPolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioColumnMaskingPolicyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	datasetID := state.DatasetID.ValueString()
+	dataset, err := getPolicyDataset(r.client, projectID, datasetID)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Dataset %s already deleted", datasetID))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read the dataset of the column masking policy, got error: %s", err),
+		)
+		return
+	}
+
+	policy := r.policyFromModel(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sqlReq := models.SQLRequest{SQL: fmt.Sprintf("ALTER %s %s MODIFY COLUMN %s UNSET MASKING POLICY %s",
+		datasetKeyword(dataset), quoteSQLPath(dataset.Path), quoteSQLIdentifier(state.Column.ValueString()), quoteSQLPath(policy.Function))}
+	if _, err := runSQLJob(ctx, r.client, projectID, sqlReq, policyStatementTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to unset column masking policy, got error: %s", err),
+		)
+		return
+	}
+}
+
+// setPolicy sets the masking policy of the column, and saves the ID and dataset path into data
+func (r *dremioColumnMaskingPolicy) setPolicy(ctx context.Context, data *models.DremioColumnMaskingPolicyModel, errorSummary string, diags *diag.Diagnostics) {
+	projectID := data.ProjectID.ValueString()
+	datasetID := data.DatasetID.ValueString()
+	dataset, err := getPolicyDataset(r.client, projectID, datasetID)
+	if err != nil {
+		diags.AddError(
+			"Client Error", fmt.Sprintf("Unable to read the dataset of the column masking policy, got error: %s", err),
+		)
+		return
+	}
+
+	policy := r.policyFromModel(ctx, data, diags)
+	if diags.HasError() {
+		return
+	}
+
+	sqlReq := models.SQLRequest{SQL: fmt.Sprintf("ALTER %s %s MODIFY COLUMN %s SET MASKING POLICY %s",
+		datasetKeyword(dataset), quoteSQLPath(dataset.Path), quoteSQLIdentifier(data.Column.ValueString()), policy.sql())}
+	if _, err := runSQLJob(ctx, r.client, projectID, sqlReq, policyStatementTimeout); err != nil {
+		diags.AddError(
+			"Client Error", fmt.Sprintf("%s, got error: %s", errorSummary, err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(columnMaskingPolicyID(datasetID, data.Column.ValueString()))
+	datasetPath, d := types.ListValueFrom(ctx, types.StringType, dataset.Path)
+	diags.Append(d...)
+	data.DatasetPath = datasetPath
+}
+
+// policyFromModel returns the policy described by the Terraform model
+func (r *dremioColumnMaskingPolicy) policyFromModel(ctx context.Context, data *models.DremioColumnMaskingPolicyModel, diags *diag.Diagnostics) datasetPolicy {
+	var policy datasetPolicy
+	diags.Append(data.UDFPath.ElementsAs(ctx, &policy.Function, false)...)
+	diags.Append(data.Arguments.ElementsAs(ctx, &policy.Arguments, false)...)
+	return policy
+}
+
+// columnMaskingPolicyID returns the ID of a policy, which is also its import ID
+func columnMaskingPolicyID(datasetID, column string) string {
+	return datasetID + ":" + column
+}
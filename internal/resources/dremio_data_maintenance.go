@@ -5,13 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"strings"
 
 	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
 	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
 	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -57,6 +55,11 @@ func (r *dremioDataMaintenance) Configure(_ context.Context, req resource.Config
 		return
 	}
 
+	if err := client.RequireCloud("dremio_data_maintenance"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -66,6 +69,7 @@ func (r *dremioDataMaintenance) Schema(_ context.Context, _ resource.SchemaReque
 		MarkdownDescription: "Manages a Dremio Cloud data maintenance task. Data maintenance tasks automate OPTIMIZE and EXPIRE_SNAPSHOTS operations on tables in Open Catalog.",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier (UUID) of the maintenance task",
 				Computed:            true,
@@ -107,8 +111,8 @@ func (r *dremioDataMaintenance) Schema(_ context.Context, _ resource.SchemaReque
 }
 
 func (r *dremioDataMaintenance) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve import ID, optionally prefixed with the project ID, and save to id attribute
+	importStateInProject(ctx, "id", req, resp)
 }
 
 // Create a new resource.
@@ -129,7 +133,7 @@ func (r *dremioDataMaintenance) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// Make API request
-	api_resp, err := r.client.RequestToDremio("POST", "/maintenance/tasks", reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/maintenance/tasks", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to create data maintenance task, got error: %s", err),
@@ -179,10 +183,10 @@ func (r *dremioDataMaintenance) Read(ctx context.Context, req resource.ReadReque
 	id := state.ID.ValueString()
 
 	var taskResp models.MaintenanceTaskResponse
-	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/maintenance/tasks/%s", id), nil)
+	api_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/maintenance/tasks/%s", id), nil)
 	if err != nil {
 		// If resource is not found (404), remove it from state so Terraform will recreate it
-		if strings.Contains(err.Error(), "status 404") {
+		if dremioClient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Data maintenance task %s not found, removing from state", id))
 			resp.State.RemoveResource(ctx)
 			return
@@ -243,7 +247,7 @@ func (r *dremioDataMaintenance) Update(ctx context.Context, req resource.UpdateR
 
 	tflog.Debug(ctx, fmt.Sprintf("Data maintenance task update request with ID: %s", id))
 
-	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/maintenance/tasks/%s", id), reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/maintenance/tasks/%s", id), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update data maintenance task, got error: %s", err),
@@ -290,7 +294,7 @@ func (r *dremioDataMaintenance) Delete(ctx context.Context, req resource.DeleteR
 
 	id := state.ID.ValueString()
 
-	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/maintenance/tasks/%s", id), nil)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "DELETE", fmt.Sprintf("/maintenance/tasks/%s", id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to delete data maintenance task, got error: %s", err),
@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -70,6 +69,7 @@ func (r *dremioDatasetTags) Schema(_ context.Context, _ resource.SchemaRequest,
 		MarkdownDescription: "Manages tags for a Dremio dataset. Tags are case-insensitive labels that can be applied to datasets for organization and discovery.",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"dataset_id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the dataset",
 				Required:            true,
@@ -99,8 +99,8 @@ func (r *dremioDatasetTags) Schema(_ context.Context, _ resource.SchemaRequest,
 }
 
 func (r *dremioDatasetTags) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to dataset_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("dataset_id"), req, resp)
+	// Retrieve import ID, optionally prefixed with the project ID, and save to dataset_id attribute
+	importStateInProject(ctx, "dataset_id", req, resp)
 }
 
 func (r *dremioDatasetTags) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -124,7 +124,7 @@ func (r *dremioDatasetTags) Delete(ctx context.Context, req resource.DeleteReque
 		Version: version,
 	}
 
-	_, err := r.client.RequestToDremio("POST", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), reqBody)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "POST", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to delete dataset tags, got error: %s", err),
@@ -146,10 +146,10 @@ func (r *dremioDatasetTags) Read(ctx context.Context, req resource.ReadRequest,
 	datasetID := state.DatasetID.ValueString()
 
 	var tagResp models.TagResponse
-	tag_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), nil)
+	tag_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), nil)
 	if err != nil {
 		// If resource is not found (404), remove it from state so Terraform will recreate it
-		if strings.Contains(err.Error(), "status 404") {
+		if dremioClient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Dataset tags for %s not found, removing from state", datasetID))
 			resp.State.RemoveResource(ctx)
 			return
@@ -204,7 +204,7 @@ func (r *dremioDatasetTags) Create(ctx context.Context, req resource.CreateReque
 	// This is necessary because a dataset might already have an empty tags array with a version
 	tflog.Debug(ctx, fmt.Sprintf("Checking for existing tags on dataset: %s", datasetID))
 
-	existing_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), nil)
+	existing_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), nil)
 	var existingVersion string
 
 	if err == nil {
@@ -239,7 +239,7 @@ func (r *dremioDatasetTags) Create(ctx context.Context, req resource.CreateReque
 		tflog.Debug(ctx, "Creating new tags")
 	}
 
-	api_resp, err = r.client.RequestToDremio("POST", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), reqBody)
+	api_resp, err = r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to %s dataset tags, got error: %s", strings.ToLower(method), err),
@@ -305,7 +305,7 @@ func (r *dremioDatasetTags) Update(ctx context.Context, req resource.UpdateReque
 
 	tflog.Debug(ctx, fmt.Sprintf("Dataset tags update request for dataset: %s, with Version: %s", datasetID, reqBody.Version))
 
-	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s/collaboration/tag", datasetID), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update dataset tags, got error: %s", err),
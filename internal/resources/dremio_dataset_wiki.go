@@ -6,12 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 
 	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
 	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -66,6 +64,7 @@ func (r *dremioDatasetWiki) Schema(_ context.Context, _ resource.SchemaRequest,
 		MarkdownDescription: "Manages wiki content for a Dremio dataset. Wiki content uses GitHub-flavored Markdown for formatting.",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"dataset_id": schema.StringAttribute{
 				MarkdownDescription: "UUID of the source, folder, or dataset for which to manage the wiki",
 				Required:            true,
@@ -86,8 +85,8 @@ func (r *dremioDatasetWiki) Schema(_ context.Context, _ resource.SchemaRequest,
 }
 
 func (r *dremioDatasetWiki) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to dataset_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("dataset_id"), req, resp)
+	// Retrieve import ID, optionally prefixed with the project ID, and save to dataset_id attribute
+	importStateInProject(ctx, "dataset_id", req, resp)
 }
 
 func (r *dremioDatasetWiki) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -107,7 +106,7 @@ func (r *dremioDatasetWiki) Delete(ctx context.Context, req resource.DeleteReque
 		Version: &version,
 	}
 
-	_, err := r.client.RequestToDremio("POST", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), reqBody)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "POST", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to delete dataset wiki, got error: %s", err),
@@ -129,10 +128,10 @@ func (r *dremioDatasetWiki) Read(ctx context.Context, req resource.ReadRequest,
 	datasetID := state.DatasetID.ValueString()
 
 	var wikiResp models.WikiResponse
-	wiki_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), nil)
+	wiki_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), nil)
 	if err != nil {
 		// If resource is not found (404), remove it from state so Terraform will recreate it
-		if strings.Contains(err.Error(), "status 404") {
+		if dremioClient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Dataset wiki for %s not found, removing from state", datasetID))
 			resp.State.RemoveResource(ctx)
 			return
@@ -187,7 +186,7 @@ func (r *dremioDatasetWiki) Create(ctx context.Context, req resource.CreateReque
 	// This is necessary because a dataset might already have a wiki with a version
 	tflog.Debug(ctx, fmt.Sprintf("Checking for existing wiki on dataset: %s", datasetID))
 
-	existing_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), nil)
+	existing_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), nil)
 	var existingVersion *int
 
 	if err == nil {
@@ -221,7 +220,7 @@ func (r *dremioDatasetWiki) Create(ctx context.Context, req resource.CreateReque
 		tflog.Debug(ctx, "Creating new wiki")
 	}
 
-	api_resp, err = r.client.RequestToDremio("POST", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), reqBody)
+	api_resp, err = r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to create dataset wiki, got error: %s", err),
@@ -288,7 +287,7 @@ func (r *dremioDatasetWiki) Update(ctx context.Context, req resource.UpdateReque
 
 	tflog.Debug(ctx, fmt.Sprintf("Dataset wiki update request for dataset: %s, with Version: %d", datasetID, version))
 
-	api_resp, err := r.client.RequestToDremio("POST", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "POST", fmt.Sprintf("/catalog/%s/collaboration/wiki", datasetID), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update dataset wiki, got error: %s", err),
@@ -10,7 +10,6 @@ import (
 	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -53,6 +52,11 @@ func (r *dremioEngine) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
+	if err := client.RequireCloud("dremio_engine"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -61,6 +65,7 @@ func (r *dremioEngine) Schema(_ context.Context, _ resource.SchemaRequest, resp
 		MarkdownDescription: "Dremio Engine resource - manages compute engines in Dremio Cloud",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the engine (UUID)",
 				Computed:            true,
@@ -159,7 +164,7 @@ func (r *dremioEngine) Create(ctx context.Context, req resource.CreateRequest, r
 	// Generate a unique request ID for idempotency
 	reqBody.RequestID = uuid.New().String()
 
-	api_resp, err := r.client.RequestToDremio("POST", "/engines", reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/engines", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to create engine, got error: %s", err),
@@ -193,7 +198,7 @@ func (r *dremioEngine) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// If enable is false, disable the engine after creation (engines are created enabled by default)
 	if !data.Enable.ValueBool() {
-		_, err = r.client.RequestToDremio("PUT", fmt.Sprintf("/engines/%s/disable", createResp.ID), nil)
+		_, err = r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "PUT", fmt.Sprintf("/engines/%s/disable", createResp.ID), nil)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Client Error", fmt.Sprintf("Unable to disable engine after creation, got error: %s", err),
@@ -249,7 +254,7 @@ func (r *dremioEngine) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Name is not allowed to be updated, set to empty string (omitted from json)
 	reqBody.Name = ""
 
-	_, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/engines/%s", id), reqBody)
+	_, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/engines/%s", id), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update engine, got error: %s", err),
@@ -269,7 +274,7 @@ func (r *dremioEngine) Update(ctx context.Context, req resource.UpdateRequest, r
 			enablePath = fmt.Sprintf("/engines/%s/disable", id)
 		}
 
-		_, err = r.client.RequestToDremio("PUT", enablePath, nil)
+		_, err = r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", enablePath, nil)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Client Error", fmt.Sprintf("Unable to change engine enable state, got error: %s", err),
@@ -296,10 +301,10 @@ func (r *dremioEngine) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	id := state.ID.ValueString()
-	api_resp, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/engines/%s", id), nil)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "DELETE", fmt.Sprintf("/engines/%s", id), nil)
 	if err != nil {
 		// If engine doesn't exist (404/400), treat as successful delete
-		if api_resp.StatusCode == 404 || api_resp.StatusCode == 400 {
+		if dremioClient.IsNotFound(err) || dremioClient.IsBadRequest(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Engine %s already deleted or doesn't exist", id))
 			return
 		}
@@ -311,7 +316,7 @@ func (r *dremioEngine) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *dremioEngine) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateInProject(ctx, "id", req, resp)
 }
 
 // parseResourceToRequestBody converts the Terraform model to an API request body
@@ -342,9 +347,9 @@ func (r *dremioEngine) parseResourceToRequestBody(_ context.Context, data *model
 func (r *dremioEngine) readEngineState(ctx context.Context, state *models.DremioEngineModel, resp interface{}) {
 	id := state.ID.ValueString()
 
-	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/engines/%s", id), nil)
+	api_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/engines/%s", id), nil)
 	if err != nil {
-		if api_resp.StatusCode == 404 || api_resp.StatusCode == 400 {
+		if dremioClient.IsNotFound(err) || dremioClient.IsBadRequest(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Engine %s not found, removing from state", id))
 			if readResp, ok := resp.(*resource.ReadResponse); ok {
 				readResp.State.RemoveResource(ctx)
@@ -52,6 +52,11 @@ func (r *dremioEngineRuleSet) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
+	if err := client.RequireCloud("dremio_engine_rule_set"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -95,6 +100,7 @@ func (r *dremioEngineRuleSet) Schema(_ context.Context, _ resource.SchemaRequest
 - When this resource is applied, any existing rules not defined in the resource will be deleted.`,
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"rule_infos": schema.ListNestedAttribute{
 				MarkdownDescription: "List of routing rules. Rules are evaluated in order. When adding rules, include all existing rules you want to retain; otherwise, they will be deleted.",
 				Optional:            true,
@@ -139,7 +145,7 @@ func (r *dremioEngineRuleSet) Delete(ctx context.Context, req resource.DeleteReq
 		},
 	}
 
-	_, err := r.client.RequestToDremio("PUT", "/rules", reqBody)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "PUT", "/rules", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to delete engine rules, got error: %s", err),
@@ -160,7 +166,7 @@ func (r *dremioEngineRuleSet) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	var rulesResp models.EngineRulesResponse
-	rules_resp, err := r.client.RequestToDremio("GET", "/rules", nil)
+	rules_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", "/rules", nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to read engine rules, got error: %s", err),
@@ -202,7 +208,7 @@ func (r *dremioEngineRuleSet) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Check for existing rules and warn about overriding
-	existingResp, err := r.client.RequestToDremio("GET", "/rules", nil)
+	existingResp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", "/rules", nil)
 	if err == nil {
 		defer existingResp.Body.Close()
 		existingBody, readErr := io.ReadAll(existingResp.Body)
@@ -219,7 +225,7 @@ func (r *dremioEngineRuleSet) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	api_resp, err := r.client.RequestToDremio("PUT", "/rules", reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "PUT", "/rules", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to create engine rules, got error: %s", err),
@@ -261,7 +267,7 @@ func (r *dremioEngineRuleSet) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Check for existing rules and warn about overriding
-	existingResp, err := r.client.RequestToDremio("GET", "/rules", nil)
+	existingResp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "GET", "/rules", nil)
 	if err == nil {
 		defer existingResp.Body.Close()
 		existingBody, readErr := io.ReadAll(existingResp.Body)
@@ -278,7 +284,7 @@ func (r *dremioEngineRuleSet) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	api_resp, err := r.client.RequestToDremio("PUT", "/rules", reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", "/rules", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update engine rules, got error: %s", err),
@@ -362,7 +368,7 @@ func (r *dremioEngineRuleSet) parseResourceToRequestBody(ctx context.Context, da
 	}
 
 	// RuleInfoDefault is computed - fetch from API to include in request
-	currentRulesResp, err := r.client.RequestToDremio("GET", "/rules", nil)
+	currentRulesResp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", "/rules", nil)
 	if err != nil {
 		diags.AddError(
 			"Client Error",
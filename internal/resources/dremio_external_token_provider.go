@@ -0,0 +1,331 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioExternalTokenProvider{}
+	_ resource.ResourceWithConfigure   = &dremioExternalTokenProvider{}
+	_ resource.ResourceWithImportState = &dremioExternalTokenProvider{}
+)
+
+type dremioExternalTokenProvider struct {
+	client *dremioClient.Client
+}
+
+func NewDremioExternalTokenProviderResource() resource.Resource {
+	return &dremioExternalTokenProvider{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioExternalTokenProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_token_provider"
+}
+
+func (r *dremioExternalTokenProvider) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_external_token_provider"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioExternalTokenProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dremioExternalTokenProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio External Token Provider resource - lets applications exchange JWTs issued by an external identity provider for Dremio access tokens.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the external token provider",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the external token provider",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"audience": schema.ListAttribute{
+				MarkdownDescription: "Intended recipients of the JWT, matched against its `aud` claim",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"user_claim": schema.StringAttribute{
+				MarkdownDescription: "Claim of the JWT that holds the Dremio user name, e.g. `email`",
+				Required:            true,
+			},
+			"issuer_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the principal that issued the JWT, matched against its `iss` claim",
+				Required:            true,
+			},
+			"jwks_url": schema.StringAttribute{
+				MarkdownDescription: "Endpoint that hosts the JSON Web Key Set used to verify the JWT. Defaults to the one published by the issuer.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the external token provider accepts JWTs. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the external token provider was created (UTC)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioExternalTokenProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioExternalTokenProviderModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var audience []string
+	resp.Diagnostics.Append(data.Audience.ElementsAs(ctx, &audience, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.ExternalTokenProviderCreateRequest{
+		Name:      data.Name.ValueString(),
+		Audience:  audience,
+		UserClaim: data.UserClaim.ValueString(),
+		IssuerURL: data.IssuerURL.ValueString(),
+		JwksURL:   data.JwksURL.ValueString(),
+		Enabled:   data.Enabled.ValueBoolPointer(),
+	}
+
+	// External token providers are organization-wide, so they use the global endpoint
+	api_resp, err := r.client.RequestToDremio("POST", "/external-token-providers", reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create external token provider, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	providerResp := r.parseExternalTokenProviderResponse(api_resp.Body, &resp.Diagnostics)
+	if providerResp == nil {
+		return
+	}
+
+	r.fromResponseToState(providerResp, &data)
+
+	tflog.Trace(ctx, "created an external token provider resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioExternalTokenProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioExternalTokenProviderModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/external-token-providers/%s", id), nil, true)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("External token provider %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read external token provider, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	providerResp := r.parseExternalTokenProviderResponse(api_resp.Body, &resp.Diagnostics)
+	if providerResp == nil {
+		return
+	}
+
+	// Refresh user-managed fields to detect changes made outside of Terraform
+	audience, diags := types.ListValueFrom(ctx, types.StringType, providerResp.Audience)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Name = types.StringValue(providerResp.Name)
+	state.Audience = audience
+	state.UserClaim = types.StringValue(providerResp.UserClaim)
+	state.IssuerURL = types.StringValue(providerResp.IssuerURL)
+	state.Enabled = types.BoolValue(providerResp.Enabled)
+	if providerResp.JwksURL != "" || !state.JwksURL.IsNull() {
+		state.JwksURL = types.StringValue(providerResp.JwksURL)
+	}
+	r.fromResponseToState(providerResp, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioExternalTokenProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioExternalTokenProviderModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var audience []string
+	resp.Diagnostics.Append(plan.Audience.ElementsAs(ctx, &audience, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueString()
+	reqBody := &models.ExternalTokenProviderUpdateRequest{
+		Name:      plan.Name.ValueString(),
+		Audience:  audience,
+		UserClaim: plan.UserClaim.ValueString(),
+		IssuerURL: plan.IssuerURL.ValueString(),
+		JwksURL:   plan.JwksURL.ValueString(),
+		Enabled:   plan.Enabled.ValueBoolPointer(),
+	}
+
+	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/external-token-providers/%s", id), reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update external token provider, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	providerResp := r.parseExternalTokenProviderResponse(api_resp.Body, &resp.Diagnostics)
+	if providerResp == nil {
+		return
+	}
+
+	r.fromResponseToState(providerResp, &plan)
+
+	tflog.Trace(ctx, "updated an external token provider resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioExternalTokenProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioExternalTokenProviderModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/external-token-providers/%s", id), nil, true)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("External token provider %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete external token provider, got error: %s", err),
+		)
+		return
+	}
+}
+
+// parseExternalTokenProviderResponse reads and decodes an external token provider from an API response body
+func (r *dremioExternalTokenProvider) parseExternalTokenProviderResponse(body io.Reader, diags *diag.Diagnostics) *models.ExternalTokenProviderResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var providerResp models.ExternalTokenProviderResponse
+	if err := json.Unmarshal(resp_body, &providerResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &providerResp
+}
+
+func (r *dremioExternalTokenProvider) fromResponseToState(providerResp *models.ExternalTokenProviderResponse, state *models.DremioExternalTokenProviderModel) {
+	state.ID = types.StringValue(providerResp.ID)
+	state.CreatedAt = types.StringValue(providerResp.CreatedAt)
+	// Keep the default Dremio reports when jwks_url is not configured
+	if providerResp.JwksURL != "" || state.JwksURL.IsUnknown() {
+		state.JwksURL = types.StringValue(providerResp.JwksURL)
+	}
+}
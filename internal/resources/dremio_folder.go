@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"regexp"
-	"strings"
 
 	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
 	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
@@ -14,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -66,8 +64,8 @@ func (r *dremioFolder) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *dremioFolder) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve import ID, optionally prefixed with the project ID, and save to id attribute
+	importStateInProject(ctx, "id", req, resp)
 }
 
 func (r *dremioFolder) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -79,7 +77,7 @@ func (r *dremioFolder) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	id := state.ID.ValueString()
-	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/catalog/%s", id), nil)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "DELETE", fmt.Sprintf("/catalog/%s", id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to delete source, got error: %s", err),
@@ -93,6 +91,7 @@ func (r *dremioFolder) Schema(_ context.Context, _ resource.SchemaRequest, resp
 		MarkdownDescription: "Dremio Folder resource",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the folder",
 				Computed:            true,
@@ -187,7 +186,7 @@ func (r *dremioFolder) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Make API request
-	api_resp, err := r.client.RequestToDremio("POST", "/catalog", reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/catalog", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to create source, got error: %s", err),
@@ -227,7 +226,7 @@ func (r *dremioFolder) Create(ctx context.Context, req resource.CreateRequest, r
 		}
 
 		// Make API request
-		api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/catalog/%s", data.ID.ValueString()), reqBody)
+		api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s", data.ID.ValueString()), reqBody)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Client Error", fmt.Sprintf("Unable to create source, got error: %s", err),
@@ -276,10 +275,10 @@ func (r *dremioFolder) Read(ctx context.Context, req resource.ReadRequest, resp
 	id := state.ID.ValueString()
 
 	var folderResp models.FolderResponse
-	folder_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s", id), nil)
+	folder_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s", id), nil)
 	if err != nil {
 		// If resource is not found (404), remove it from state so Terraform will recreate it
-		if strings.Contains(err.Error(), "status 404") {
+		if dremioClient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Folder %s not found, removing from state", id))
 			resp.State.RemoveResource(ctx)
 			return
@@ -346,7 +345,7 @@ func (r *dremioFolder) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	tflog.Debug(ctx, fmt.Sprintf("Folder update request with ID: %s, and Tag: %s", id, reqBody.Tag))
 
-	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/catalog/%s", id), reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s", id), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update source, got error: %s", err),
@@ -12,7 +12,6 @@ import (
 	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -69,6 +68,7 @@ func (r *dremioGrants) Schema(_ context.Context, _ resource.SchemaRequest, resp
 		MarkdownDescription: "Manages grants (privileges) on a Dremio catalog object. This resource allows you to grant privileges to users and roles on catalog objects such as sources, spaces, folders, datasets, views, and UDFs.\n\n**Important:** This resource manages ALL grants on the catalog object. When this resource is created, it will **overwrite** any existing grants on the object. When destroyed, it will remove all grants from the object.",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"catalog_object_id": schema.StringAttribute{
 				MarkdownDescription: "UUID of the Dremio catalog object to manage grants for.",
 				Required:            true,
@@ -113,8 +113,8 @@ func (r *dremioGrants) Schema(_ context.Context, _ resource.SchemaRequest, resp
 }
 
 func (r *dremioGrants) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to catalog_object_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("catalog_object_id"), req, resp)
+	// Retrieve import ID, optionally prefixed with the project ID, and save to catalog_object_id attribute
+	importStateInProject(ctx, "catalog_object_id", req, resp)
 }
 
 func (r *dremioGrants) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -135,7 +135,7 @@ func (r *dremioGrants) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	tflog.Debug(ctx, fmt.Sprintf("Deleting grants for catalog object: %s (setting to empty array)", catalogObjectID))
 
-	_, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), reqBody)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to delete grants, got error: %s", err),
@@ -157,10 +157,10 @@ func (r *dremioGrants) Read(ctx context.Context, req resource.ReadRequest, resp
 	catalogObjectID := state.CatalogObjectID.ValueString()
 
 	var grantsResp models.GrantsResponse
-	apiResp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
+	apiResp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
 	if err != nil {
 		// If resource is not found (404), remove it from state so Terraform will recreate it
-		if strings.Contains(err.Error(), "status 404") {
+		if dremioClient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Grants for catalog object %s not found, removing from state", catalogObjectID))
 			resp.State.RemoveResource(ctx)
 			return
@@ -214,7 +214,7 @@ func (r *dremioGrants) Create(ctx context.Context, req resource.CreateRequest, r
 	// First, check for existing grants and warn the user if they exist
 	tflog.Debug(ctx, fmt.Sprintf("Checking for existing grants on catalog object: %s", catalogObjectID))
 
-	existingResp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
+	existingResp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
 	if err == nil {
 		defer existingResp.Body.Close()
 		existingBody, readErr := io.ReadAll(existingResp.Body)
@@ -240,7 +240,7 @@ func (r *dremioGrants) Create(ctx context.Context, req resource.CreateRequest, r
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating grants for catalog object: %s", catalogObjectID))
 
-	apiResp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), reqBody)
+	apiResp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to create grants, got error: %s", err),
@@ -250,7 +250,7 @@ func (r *dremioGrants) Create(ctx context.Context, req resource.CreateRequest, r
 	defer apiResp.Body.Close()
 
 	// PUT returns 204 No Content, so we need to GET to retrieve the current state
-	getResp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
+	getResp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to read grants after creation, got error: %s", err),
@@ -304,7 +304,7 @@ func (r *dremioGrants) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating grants for catalog object: %s", catalogObjectID))
 
-	apiResp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), reqBody)
+	apiResp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update grants, got error: %s", err),
@@ -314,7 +314,7 @@ func (r *dremioGrants) Update(ctx context.Context, req resource.UpdateRequest, r
 	defer apiResp.Body.Close()
 
 	// PUT returns 204 No Content, so we need to GET to retrieve the current state
-	getResp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
+	getResp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s/grants", catalogObjectID), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to read grants after update, got error: %s", err),
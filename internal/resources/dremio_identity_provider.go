@@ -0,0 +1,368 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &dremioIdentityProvider{}
+	_ resource.ResourceWithConfigure      = &dremioIdentityProvider{}
+	_ resource.ResourceWithImportState    = &dremioIdentityProvider{}
+	_ resource.ResourceWithValidateConfig = &dremioIdentityProvider{}
+)
+
+// identityProviderURLAttributes maps each identity provider type to the attribute that locates it
+var identityProviderURLAttributes = map[string]string{
+	"GENERIC_OIDC": "issuer_url",
+	"AZURE_AD":     "domain",
+	"OKTA":         "okta_url",
+}
+
+type dremioIdentityProvider struct {
+	client *dremioClient.Client
+}
+
+func NewDremioIdentityProviderResource() resource.Resource {
+	return &dremioIdentityProvider{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioIdentityProvider) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_provider"
+}
+
+func (r *dremioIdentityProvider) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_identity_provider"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioIdentityProvider) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dremioIdentityProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Identity Provider resource - configures an OpenID Connect identity provider (generic OIDC, Microsoft Entra ID or Okta) as a login option of the Dremio Cloud organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the identity provider",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of identity provider (GENERIC_OIDC, AZURE_AD or OKTA). Changing it creates a new identity provider.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("GENERIC_OIDC", "AZURE_AD", "OKTA"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether users can log in with the identity provider. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"issuer_url": schema.StringAttribute{
+				MarkdownDescription: "Issuer URL of the identity provider (required for GENERIC_OIDC)",
+				Optional:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Publisher domain of the Microsoft Entra ID application (required for AZURE_AD)",
+				Optional:            true,
+			},
+			"okta_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the Okta organization (required for OKTA)",
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client or application ID registered with the identity provider",
+				Required:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret registered with the identity provider. Dremio never returns it, so changes made outside of Terraform are not detected.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"redirect_url": schema.StringAttribute{
+				MarkdownDescription: "Redirect URL to register with the identity provider",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the attribute locating the identity provider of the configured type is set.
+func (r *dremioIdentityProvider) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.DremioIdentityProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	attributes := []struct {
+		name  string
+		value types.String
+	}{
+		{"issuer_url", data.IssuerURL},
+		{"domain", data.Domain},
+		{"okta_url", data.OktaURL},
+	}
+	required := identityProviderURLAttributes[data.Type.ValueString()]
+	for _, attribute := range attributes {
+		switch {
+		case attribute.name == required && attribute.value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Missing Attribute",
+				fmt.Sprintf("%s is required for identity providers of type %s", attribute.name, data.Type.ValueString()),
+			)
+		case attribute.name != required && !attribute.value.IsNull() && !attribute.value.IsUnknown():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Invalid Attribute",
+				fmt.Sprintf("%s is not used by identity providers of type %s", attribute.name, data.Type.ValueString()),
+			)
+		}
+	}
+}
+
+// Create a new resource.
+func (r *dremioIdentityProvider) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioIdentityProviderModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.IdentityProviderCreateRequest{
+		Type:         data.Type.ValueString(),
+		IsActive:     data.IsActive.ValueBoolPointer(),
+		IssuerURL:    data.IssuerURL.ValueString(),
+		Domain:       data.Domain.ValueString(),
+		OktaURL:      data.OktaURL.ValueString(),
+		ClientID:     data.ClientID.ValueString(),
+		ClientSecret: data.ClientSecret.ValueString(),
+	}
+
+	// Identity providers are organization-wide, so they use the global endpoint
+	api_resp, err := r.client.RequestToDremio("POST", "/identity-providers", reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create identity provider, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	providerResp := r.parseIdentityProviderResponse(api_resp.Body, &resp.Diagnostics)
+	if providerResp == nil {
+		return
+	}
+
+	r.fromResponseToState(providerResp, &data)
+
+	tflog.Trace(ctx, "created an identity provider resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioIdentityProvider) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioIdentityProviderModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/identity-providers/%s", id), nil, true)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Identity provider %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read identity provider, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	providerResp := r.parseIdentityProviderResponse(api_resp.Body, &resp.Diagnostics)
+	if providerResp == nil {
+		return
+	}
+
+	// Refresh user-managed fields to detect changes made outside of Terraform.
+	// The client secret is never returned, so the configured one is kept.
+	state.Type = types.StringValue(providerResp.Type)
+	state.IsActive = types.BoolValue(providerResp.IsActive)
+	state.ClientID = types.StringValue(providerResp.ClientID)
+	if providerResp.IssuerURL != "" || !state.IssuerURL.IsNull() {
+		state.IssuerURL = types.StringValue(providerResp.IssuerURL)
+	}
+	if providerResp.Domain != "" || !state.Domain.IsNull() {
+		state.Domain = types.StringValue(providerResp.Domain)
+	}
+	if providerResp.OktaURL != "" || !state.OktaURL.IsNull() {
+		state.OktaURL = types.StringValue(providerResp.OktaURL)
+	}
+	r.fromResponseToState(providerResp, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioIdentityProvider) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioIdentityProviderModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.DremioIdentityProviderModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	reqBody := &models.IdentityProviderUpdateRequest{
+		IsActive:  plan.IsActive.ValueBoolPointer(),
+		IssuerURL: plan.IssuerURL.ValueString(),
+		Domain:    plan.Domain.ValueString(),
+		OktaURL:   plan.OktaURL.ValueString(),
+		ClientID:  plan.ClientID.ValueString(),
+	}
+	// Only send the client secret when it changed, so that Dremio keeps the stored one otherwise
+	if !plan.ClientSecret.Equal(state.ClientSecret) {
+		reqBody.ClientSecret = plan.ClientSecret.ValueString()
+	}
+
+	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/identity-providers/%s", id), reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update identity provider, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	providerResp := r.parseIdentityProviderResponse(api_resp.Body, &resp.Diagnostics)
+	if providerResp == nil {
+		return
+	}
+
+	r.fromResponseToState(providerResp, &plan)
+
+	tflog.Trace(ctx, "updated an identity provider resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioIdentityProvider) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioIdentityProviderModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/identity-providers/%s", id), nil, true)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Identity provider %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete identity provider, got error: %s", err),
+		)
+		return
+	}
+}
+
+// parseIdentityProviderResponse reads and decodes an identity provider from an API response body
+func (r *dremioIdentityProvider) parseIdentityProviderResponse(body io.Reader, diags *diag.Diagnostics) *models.IdentityProviderResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var providerResp models.IdentityProviderResponse
+	if err := json.Unmarshal(resp_body, &providerResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &providerResp
+}
+
+func (r *dremioIdentityProvider) fromResponseToState(providerResp *models.IdentityProviderResponse, state *models.DremioIdentityProviderModel) {
+	state.ID = types.StringValue(providerResp.ID)
+	state.RedirectURL = types.StringValue(providerResp.RedirectURL)
+}
@@ -0,0 +1,427 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioPipe{}
+	_ resource.ResourceWithConfigure   = &dremioPipe{}
+	_ resource.ResourceWithImportState = &dremioPipe{}
+)
+
+// pipeStatementTimeout is how long the pipe statements may run
+const pipeStatementTimeout = 5 * time.Minute
+
+// dremioPipe manages a pipe that loads new files of a storage location into an Iceberg table with SQL,
+// since pipes have no REST endpoint besides loading files
+type dremioPipe struct {
+	client *dremioClient.Client
+}
+
+func NewDremioPipeResource() resource.Resource {
+	return &dremioPipe{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioPipe) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipe"
+}
+
+func (r *dremioPipe) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_pipe"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioPipe) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateInProject(ctx, "name", req, resp)
+}
+
+// Schema defines the schema for the resource.
+func (r *dremioPipe) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Pipe resource - continuously loads the new files of a storage location into an Iceberg table with `COPY INTO` (Dremio Cloud only).",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the pipe, its name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the pipe, unique within the project",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table": schema.ListAttribute{
+				MarkdownDescription: "Full path of the Iceberg table the files are loaded into",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the table the files are loaded into, for tables of versioned sources",
+				Optional:            true,
+			},
+			"storage_location": schema.StringAttribute{
+				MarkdownDescription: "Source and folder the files are read from, in the form `@<source>/<folder>`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^@.+`), "must be of the form @<source>/<folder>"),
+				},
+			},
+			"file_format": schema.StringAttribute{
+				MarkdownDescription: "Format of the files (CSV, JSON or PARQUET). Defaults to the file extensions.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("CSV", "JSON", "PARQUET"),
+				},
+			},
+			"format_options": schema.StringAttribute{
+				MarkdownDescription: "Format options of `COPY INTO`, without the parentheses, e.g. `FIELD_DELIMITER ',', SKIP_FIRST_LINE true`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"dedupe_lookback_period": schema.Int64Attribute{
+				MarkdownDescription: "Number of days a file path is remembered, so that a file notified twice is loaded once. Dremio uses its default period when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"notification_provider": schema.StringAttribute{
+				MarkdownDescription: "Provider of the notifications of new files. Only `AWS_SQS` is supported. Changing this forces a new resource.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("AWS_SQS"),
+					stringvalidator.AlsoRequires(path.MatchRoot("notification_queue_reference")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notification_queue_reference": schema.StringAttribute{
+				MarkdownDescription: "Queue the notifications of new files are read from, e.g. the ARN of an SQS queue. Changing this forces a new resource.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("notification_provider")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"running": schema.BoolAttribute{
+				MarkdownDescription: "Whether the pipe loads new files. Set to `false` to pause it. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"pipe_state": schema.StringAttribute{
+				MarkdownDescription: "State of the pipe, e.g. RUNNING, PAUSED or STOPPED_MISSING_TABLE_OR_BRANCH",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioPipe) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioPipeModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	copyInto := r.copyIntoStatement(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statement := "CREATE PIPE " + quoteSQLIdentifier(data.Name.ValueString())
+	if !data.DedupeLookbackPeriod.IsNull() {
+		statement += fmt.Sprintf(" DEDUPE_LOOKBACK_PERIOD %d", data.DedupeLookbackPeriod.ValueInt64())
+	}
+	if !data.NotificationProvider.IsNull() {
+		statement += fmt.Sprintf(" NOTIFICATION_PROVIDER %s NOTIFICATION_QUEUE_REFERENCE %s",
+			data.NotificationProvider.ValueString(), quoteSQLIdentifier(data.NotificationQueueReference.ValueString()))
+	}
+	statement += " AS " + copyInto
+
+	projectID := data.ProjectID.ValueString()
+	if !r.runStatement(ctx, projectID, statement, "create pipe", &resp.Diagnostics) {
+		return
+	}
+
+	// Save the ID right away, so that a pipe that fails to be paused or read is still tracked.
+	// It is saved as running, which it is until the pause statement succeeds.
+	running := data.Running
+	data.ID = types.StringValue(data.Name.ValueString())
+	data.Running = types.BoolValue(true)
+	data.PipeState = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Pipes start running, pause the pipe right away when it is configured so
+	data.Running = running
+	if !running.ValueBool() {
+		if !r.runStatement(ctx, projectID, r.runningStatement(&data), "pause pipe", &resp.Diagnostics) {
+			return
+		}
+	}
+
+	pipeState, found, err := r.readPipeState(ctx, projectID, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read pipe, got error: %s", err),
+		)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Pipe %s was created but is not listed in sys.project.pipes", data.Name.ValueString()),
+		)
+		return
+	}
+	data.PipeState = types.StringValue(pipeState)
+
+	tflog.Trace(ctx, "created a pipe resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioPipe) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioPipeModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	pipeState, found, err := r.readPipeState(ctx, state.ProjectID.ValueString(), name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read pipe, got error: %s", err),
+		)
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("Pipe %s not found, removing from state", name))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(name)
+	state.PipeState = types.StringValue(pipeState)
+	// Detect pipes paused or resumed outside of Terraform; stopped pipes keep the configured value
+	switch pipeState {
+	case "RUNNING":
+		state.Running = types.BoolValue(true)
+	case "PAUSED":
+		state.Running = types.BoolValue(false)
+	}
+	if state.Running.IsNull() {
+		state.Running = types.BoolValue(true)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioPipe) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioPipeModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.DremioPipeModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	name := quoteSQLIdentifier(plan.Name.ValueString())
+
+	// Replace the COPY INTO definition when it changed
+	if !plan.Table.Equal(state.Table) ||
+		!plan.Branch.Equal(state.Branch) ||
+		!plan.StorageLocation.Equal(state.StorageLocation) ||
+		!plan.FileFormat.Equal(state.FileFormat) ||
+		!plan.FormatOptions.Equal(state.FormatOptions) ||
+		!plan.DedupeLookbackPeriod.Equal(state.DedupeLookbackPeriod) {
+		copyInto := r.copyIntoStatement(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		statement := "ALTER PIPE " + name
+		if !plan.DedupeLookbackPeriod.IsNull() {
+			statement += fmt.Sprintf(" DEDUPE_LOOKBACK_PERIOD %d", plan.DedupeLookbackPeriod.ValueInt64())
+		}
+		statement += " AS " + copyInto
+		if !r.runStatement(ctx, projectID, statement, "update pipe", &resp.Diagnostics) {
+			return
+		}
+	}
+
+	if !plan.Running.Equal(state.Running) {
+		if !r.runStatement(ctx, projectID, r.runningStatement(&plan), "pause or resume pipe", &resp.Diagnostics) {
+			return
+		}
+	}
+
+	pipeState, _, err := r.readPipeState(ctx, projectID, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read pipe, got error: %s", err),
+		)
+		return
+	}
+	plan.ID = state.ID
+	plan.PipeState = types.StringValue(pipeState)
+
+	tflog.Trace(ctx, "updated a pipe resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioPipe) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioPipeModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statement := "DROP PIPE IF EXISTS " + quoteSQLIdentifier(state.Name.ValueString())
+	r.runStatement(ctx, state.ProjectID.ValueString(), statement, "drop pipe", &resp.Diagnostics)
+}
+
+// copyIntoStatement builds the COPY INTO statement the pipe runs for new files
+func (r *dremioPipe) copyIntoStatement(ctx context.Context, data *models.DremioPipeModel, diags *diag.Diagnostics) string {
+	var table []string
+	diags.Append(data.Table.ElementsAs(ctx, &table, false)...)
+	if diags.HasError() {
+		return ""
+	}
+
+	statement := "COPY INTO " + quoteSQLPath(table)
+	if !data.Branch.IsNull() {
+		statement += " AT BRANCH " + quoteSQLIdentifier(data.Branch.ValueString())
+	}
+	statement += " FROM " + quoteSQLString(data.StorageLocation.ValueString())
+	if !data.FileFormat.IsNull() {
+		statement += " FILE_FORMAT " + quoteSQLString(strings.ToLower(data.FileFormat.ValueString()))
+	}
+	if !data.FormatOptions.IsNull() {
+		statement += " (" + data.FormatOptions.ValueString() + ")"
+	}
+	return statement
+}
+
+// runningStatement builds the statement that pauses or resumes the pipe to match running
+func (r *dremioPipe) runningStatement(data *models.DremioPipeModel) string {
+	running := "FALSE"
+	if data.Running.ValueBool() {
+		running = "TRUE"
+	}
+	return fmt.Sprintf("ALTER PIPE %s SET PIPE_EXECUTION_RUNNING = %s", quoteSQLIdentifier(data.Name.ValueString()), running)
+}
+
+// runStatement runs a pipe statement, reporting a failure as an error of the named operation
+func (r *dremioPipe) runStatement(ctx context.Context, projectID, statement, operation string, diags *diag.Diagnostics) bool {
+	sqlReq := models.SQLRequest{SQL: statement}
+	if _, err := runSQLJob(ctx, r.client, projectID, sqlReq, pipeStatementTimeout); err != nil {
+		diags.AddError(
+			"Client Error", fmt.Sprintf("Unable to %s, got error: %s", operation, err),
+		)
+		return false
+	}
+	return true
+}
+
+// readPipeState returns the state of the pipe from sys.project.pipes, and whether it exists
+func (r *dremioPipe) readPipeState(ctx context.Context, projectID, name string) (string, bool, error) {
+	sqlReq := models.SQLRequest{SQL: "SELECT pipe_state FROM sys.project.pipes WHERE pipe_name = " + quoteSQLString(name)}
+	jobID, err := runSQLJob(ctx, r.client, projectID, sqlReq, pipeStatementTimeout)
+	if err != nil {
+		return "", false, err
+	}
+
+	results, err := r.client.GetJobResults(projectID, jobID, 1)
+	if err != nil {
+		return "", false, err
+	}
+	if len(results.Rows) == 0 {
+		return "", false, nil
+	}
+
+	pipeState, _ := results.Rows[0]["pipe_state"].(string)
+	return pipeState, true, nil
+}
@@ -0,0 +1,523 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioProject{}
+	_ resource.ResourceWithConfigure   = &dremioProject{}
+	_ resource.ResourceWithImportState = &dremioProject{}
+)
+
+const (
+	// projectPollInterval is the time between two reads of the project state
+	projectPollInterval = 10 * time.Second
+	// projectPollTimeout is the maximum time to wait for a project to become ACTIVE or to be deleted
+	projectPollTimeout = 30 * time.Minute
+)
+
+type dremioProject struct {
+	client *dremioClient.Client
+}
+
+func NewDremioProjectResource() resource.Resource {
+	return &dremioProject{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioProject) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *dremioProject) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_project"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioProject) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dremioProject) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Project resource - manages a Dremio Cloud project. Creation waits until the project is ACTIVE.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the project",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the project. Must be unique within the organization.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cloud_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the cloud where the compute resources of the project are created",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_store": schema.StringAttribute{
+				MarkdownDescription: "S3 bucket or Azure storage container where the project stores its metadata and Reflections",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"credentials": schema.SingleNestedAttribute{
+				MarkdownDescription: "Credentials Dremio uses to access the project store. Dremio never returns secrets, so changes made outside of Terraform are not detected.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of credentials (ACCESS_KEY, IAM_ROLE or AZURE_STORAGE_CLIENT_CREDENTIALS)",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ACCESS_KEY", "IAM_ROLE", "AZURE_STORAGE_CLIENT_CREDENTIALS"),
+						},
+					},
+					"access_key_id": schema.StringAttribute{
+						MarkdownDescription: "AWS access key ID (for ACCESS_KEY)",
+						Optional:            true,
+					},
+					"secret_access_key": schema.StringAttribute{
+						MarkdownDescription: "AWS secret access key (for ACCESS_KEY)",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"role_arn": schema.StringAttribute{
+						MarkdownDescription: "ARN of the AWS cross-account role (for IAM_ROLE)",
+						Optional:            true,
+					},
+					"instance_profile_arn": schema.StringAttribute{
+						MarkdownDescription: "ARN of the AWS instance profile (for IAM_ROLE)",
+						Optional:            true,
+					},
+					"external_id": schema.StringAttribute{
+						MarkdownDescription: "AWS external ID (for IAM_ROLE)",
+						Optional:            true,
+					},
+					"external_id_signature": schema.StringAttribute{
+						MarkdownDescription: "Signature of the AWS external ID (for IAM_ROLE)",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"tenant_id": schema.StringAttribute{
+						MarkdownDescription: "Azure tenant ID (for AZURE_STORAGE_CLIENT_CREDENTIALS)",
+						Optional:            true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Azure application client ID (for AZURE_STORAGE_CLIENT_CREDENTIALS)",
+						Optional:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "Azure application client secret (for AZURE_STORAGE_CLIENT_CREDENTIALS)",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"account_name": schema.StringAttribute{
+						MarkdownDescription: "Azure storage account name (for AZURE_STORAGE_CLIENT_CREDENTIALS)",
+						Optional:            true,
+					},
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the project. Defaults to the organization's default project type.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"catalog_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the primary catalog of the project (AWS only)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"catalog_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the primary catalog of the project",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Unknown on update: the PUT response may report the project between two states
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the project (e.g. ACTIVE, INACTIVE)",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the project was created (UTC)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "ID of the user who created the project",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioProject) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioProjectModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, diags := helpers.ConvertProjectCredentialsFromTerraform(ctx, data.Credentials)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.ProjectCreateRequest{
+		Name:         data.Name.ValueString(),
+		CloudID:      data.CloudID.ValueString(),
+		ProjectStore: data.ProjectStore.ValueString(),
+		Credentials:  credentials,
+		// Generate a unique request ID for idempotency
+		RequestID: uuid.New().String(),
+	}
+	if !data.Type.IsUnknown() {
+		reqBody.Type = data.Type.ValueString()
+	}
+	if !data.CatalogName.IsUnknown() {
+		reqBody.CatalogName = data.CatalogName.ValueString()
+	}
+
+	// Projects are organization-wide, so they use the global endpoint
+	api_resp, err := r.client.RequestToDremio("POST", "/projects", reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create project, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	projectResp := r.parseProjectResponse(api_resp.Body, &resp.Diagnostics)
+	if projectResp == nil {
+		return
+	}
+
+	// Save the ID right away, so that a project that fails to become ACTIVE is still tracked
+	data.ID = types.StringValue(projectResp.ID)
+	r.fromResponseToState(projectResp, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for project %s to become ACTIVE", projectResp.ID))
+
+	projectResp, err = r.waitForActive(ctx, projectResp.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Project %s was created but did not become ACTIVE: %s", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	r.fromResponseToState(projectResp, &data)
+
+	tflog.Trace(ctx, "created a project resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioProject) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioProjectModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	projectResp, err := r.getProject(id)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Project %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read project, got error: %s", err),
+		)
+		return
+	}
+
+	// Refresh user-managed fields to detect changes made outside of Terraform
+	state.Name = types.StringValue(projectResp.Name)
+	if projectResp.CloudID != "" {
+		state.CloudID = types.StringValue(projectResp.CloudID)
+	}
+	if projectResp.ProjectStore != "" {
+		state.ProjectStore = types.StringValue(projectResp.ProjectStore)
+	}
+	r.fromResponseToState(projectResp, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioProject) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioProjectModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.DremioProjectModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	reqBody := &models.ProjectUpdateRequest{
+		Name: plan.Name.ValueString(),
+	}
+	// Only send credentials when they changed, since Dremio validates them against the project store
+	if !plan.Credentials.Equal(state.Credentials) {
+		credentials, diags := helpers.ConvertProjectCredentialsFromTerraform(ctx, plan.Credentials)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		reqBody.Credentials = credentials
+	}
+
+	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/projects/%s", id), reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update project, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	projectResp := r.parseProjectResponse(api_resp.Body, &resp.Diagnostics)
+	if projectResp == nil {
+		return
+	}
+
+	r.fromResponseToState(projectResp, &plan)
+
+	tflog.Trace(ctx, "updated a project resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioProject) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioProjectModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/projects/%s", id), nil, true)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Project %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err),
+		)
+		return
+	}
+
+	// Deletion is asynchronous, wait until the project is gone
+	if err := r.waitForDeletion(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Project %s was not deleted: %s", id, err),
+		)
+		return
+	}
+}
+
+// getProject reads a project by ID
+func (r *dremioProject) getProject(id string) (*models.ProjectResponse, error) {
+	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/projects/%s", id), nil, true)
+	if err != nil {
+		return nil, err
+	}
+	defer api_resp.Body.Close()
+
+	resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body: %w", err)
+	}
+
+	var projectResp models.ProjectResponse
+	if err := json.Unmarshal(resp_body, &projectResp); err != nil {
+		return nil, fmt.Errorf("unable to parse response: %w", err)
+	}
+	return &projectResp, nil
+}
+
+// waitForActive polls the project until it is ACTIVE, it lands in a state it cannot leave by itself, or the timeout expires
+func (r *dremioProject) waitForActive(ctx context.Context, id string) (*models.ProjectResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, projectPollTimeout)
+	defer cancel()
+
+	for {
+		projectResp, err := r.getProject(id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch projectResp.State {
+		case "ACTIVE":
+			return projectResp, nil
+		case "INACTIVE", "ARCHIVED":
+			if projectResp.LastStateError != nil && projectResp.LastStateError.Error != "" {
+				return nil, fmt.Errorf("project is %s: %s", projectResp.State, projectResp.LastStateError.Error)
+			}
+			return nil, fmt.Errorf("project is %s", projectResp.State)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Project %s is %s, waiting", id, projectResp.State))
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out after %s, last state was %s", projectPollTimeout, projectResp.State)
+		case <-time.After(projectPollInterval):
+		}
+	}
+}
+
+// waitForDeletion polls the project until Dremio no longer finds it, or the timeout expires
+func (r *dremioProject) waitForDeletion(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, projectPollTimeout)
+	defer cancel()
+
+	for {
+		projectResp, err := r.getProject(id)
+		if err != nil {
+			if dremioClient.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Project %s is %s, waiting for deletion", id, projectResp.State))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s, last state was %s", projectPollTimeout, projectResp.State)
+		case <-time.After(projectPollInterval):
+		}
+	}
+}
+
+// parseProjectResponse reads and decodes a project from an API response body
+func (r *dremioProject) parseProjectResponse(body io.Reader, diags *diag.Diagnostics) *models.ProjectResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var projectResp models.ProjectResponse
+	if err := json.Unmarshal(resp_body, &projectResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &projectResp
+}
+
+func (r *dremioProject) fromResponseToState(projectResp *models.ProjectResponse, state *models.DremioProjectModel) {
+	state.ID = types.StringValue(projectResp.ID)
+	state.State = types.StringValue(projectResp.State)
+	state.CatalogID = types.StringValue(projectResp.CatalogID)
+	state.CreatedAt = types.StringValue(projectResp.CreatedAt)
+	state.CreatedBy = types.StringValue(projectResp.CreatedBy)
+
+	if projectResp.Type != "" || state.Type.IsUnknown() {
+		state.Type = types.StringValue(projectResp.Type)
+	}
+	if projectResp.CatalogName != "" || state.CatalogName.IsUnknown() {
+		state.CatalogName = types.StringValue(projectResp.CatalogName)
+	}
+}
@@ -0,0 +1,334 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &dremioRecommendedReflection{}
+	_ resource.ResourceWithConfigure = &dremioRecommendedReflection{}
+)
+
+type dremioRecommendedReflection struct {
+	client *dremioClient.Client
+}
+
+func NewDremioRecommendedReflectionResource() resource.Resource {
+	return &dremioRecommendedReflection{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioRecommendedReflection) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recommended_reflection"
+}
+
+func (r *dremioRecommendedReflection) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	if err := client.RequireCloud("dremio_recommended_reflection"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioRecommendedReflection) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Recommended Reflection resource - creates a Reflection from a usage-based recommendation in Dremio Cloud. " +
+			"Use the `dremio_reflection_recommendations` data source to list the available recommendations.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the created Reflection",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"recommendation_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the usage-based recommendation to apply. Changing it forces a new Reflection to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Reflection",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of Reflection (RAW or AGGREGATION)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the dataset the Reflection is based on",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Reflection is enabled",
+				Computed:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Version tag of the Reflection",
+				Computed:            true,
+			},
+			"status": schema.SingleNestedAttribute{
+				MarkdownDescription: "Status of the Reflection",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"config": schema.StringAttribute{
+						MarkdownDescription: "Configuration status (OK, INVALID)",
+						Computed:            true,
+					},
+					"refresh": schema.StringAttribute{
+						MarkdownDescription: "Refresh status (GIVEN_UP, MANUAL, RUNNING, SCHEDULED)",
+						Computed:            true,
+					},
+					"availability": schema.StringAttribute{
+						MarkdownDescription: "Availability status (NONE, EXPIRED, AVAILABLE)",
+						Computed:            true,
+					},
+					"combined_status": schema.StringAttribute{
+						MarkdownDescription: "Combined status of the Reflection",
+						Computed:            true,
+					},
+					"failure_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of consecutive refresh failures",
+						Computed:            true,
+					},
+					"last_data_fetch": schema.StringAttribute{
+						MarkdownDescription: "Date and time the Reflection data was last refreshed (UTC)",
+						Computed:            true,
+					},
+					"expires_at": schema.StringAttribute{
+						MarkdownDescription: "Date and time the Reflection data expires (UTC)",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create applies the recommendation by creating the recommended Reflection.
+func (r *dremioRecommendedReflection) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioRecommendedReflectionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recommendationID := data.RecommendationID.ValueString()
+	recommendation := r.findRecommendation(data.ProjectID.ValueString(), recommendationID, &resp.Diagnostics)
+	if recommendation == nil {
+		return
+	}
+
+	reqBody := &models.UsageBasedReflectionCreateRequest{
+		ReflectionRequestBody: recommendation.ReflectionRequestBody,
+		RecommendationID:      recommendationID,
+	}
+
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/reflection", reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create Reflection from recommendation %s, got error: %s", recommendationID, err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	reflectionResp := r.parseReflectionResponse(api_resp.Body, &resp.Diagnostics)
+	if reflectionResp == nil {
+		return
+	}
+
+	r.fromResponseToState(ctx, reflectionResp, &data, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created a Reflection from a usage-based recommendation")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioRecommendedReflection) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.DremioRecommendedReflectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	api_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/reflection/%s", id), nil)
+	if err != nil {
+		// If the Reflection was deleted, remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Reflection %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read Reflection, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	reflectionResp := r.parseReflectionResponse(api_resp.Body, &resp.Diagnostics)
+	if reflectionResp == nil {
+		return
+	}
+
+	r.fromResponseToState(ctx, reflectionResp, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with changes, as recommendation_id forces replacement and every other attribute is computed.
+func (r *dremioRecommendedReflection) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state models.DremioRecommendedReflectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete removes the Reflection created from the recommendation.
+func (r *dremioRecommendedReflection) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioRecommendedReflectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "DELETE", fmt.Sprintf("/reflection/%s", id), nil)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Reflection %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete Reflection, got error: %s", err),
+		)
+		return
+	}
+}
+
+// findRecommendation looks up a usage-based recommendation by ID in the given project
+func (r *dremioRecommendedReflection) findRecommendation(projectID, recommendationID string, diags *diag.Diagnostics) *models.UsageBasedRecommendation {
+	api_resp, err := r.client.RequestToDremioInProject(projectID, "GET", "/reflection/recommendations", nil)
+	if err != nil {
+		diags.AddError(
+			"Client Error", fmt.Sprintf("Unable to list usage-based Reflection recommendations, got error: %s", err),
+		)
+		return nil
+	}
+	defer api_resp.Body.Close()
+
+	resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var usageResp models.UsageBasedRecommendationsResponse
+	if err := json.Unmarshal(resp_body, &usageResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+
+	for i := range usageResp.Data {
+		if usageResp.Data[i].RecommendationID == recommendationID {
+			return &usageResp.Data[i]
+		}
+	}
+
+	diags.AddError(
+		"Recommendation Not Found",
+		fmt.Sprintf("Usage-based Reflection recommendation %s does not exist or is no longer recommended", recommendationID),
+	)
+	return nil
+}
+
+// parseReflectionResponse reads and decodes a Reflection from an API response body
+func (r *dremioRecommendedReflection) parseReflectionResponse(body io.Reader, diags *diag.Diagnostics) *models.ReflectionResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var reflectionResp models.ReflectionResponse
+	if err := json.Unmarshal(resp_body, &reflectionResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &reflectionResp
+}
+
+func (r *dremioRecommendedReflection) fromResponseToState(ctx context.Context, reflectionResp *models.ReflectionResponse, state *models.DremioRecommendedReflectionModel, diags *diag.Diagnostics) {
+	state.ID = types.StringValue(reflectionResp.ID)
+	state.Name = types.StringValue(reflectionResp.Name)
+	state.Type = types.StringValue(reflectionResp.Type)
+	state.DatasetID = types.StringValue(reflectionResp.DatasetID)
+	state.Enabled = types.BoolValue(reflectionResp.Enabled)
+	state.Tag = types.StringValue(reflectionResp.Tag)
+
+	var statusDiags diag.Diagnostics
+	state.Status, statusDiags = helpers.ConvertReflectionStatusToTerraform(ctx, reflectionResp.Status)
+	diags.Append(statusDiags...)
+}
@@ -0,0 +1,544 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioReflection{}
+	_ resource.ResourceWithConfigure   = &dremioReflection{}
+	_ resource.ResourceWithImportState = &dremioReflection{}
+)
+
+type dremioReflection struct {
+	client *dremioClient.Client
+}
+
+func NewDremioReflectionResource() resource.Resource {
+	return &dremioReflection{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioReflection) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reflection"
+}
+
+func (r *dremioReflection) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioReflection) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID, optionally prefixed with the project ID, and save to id attribute
+	importStateInProject(ctx, "id", req, resp)
+}
+
+// reflectionFieldSchema returns the schema of a list of Reflection fields referenced only by name.
+func reflectionFieldSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the dataset field",
+					Required:            true,
+				},
+			},
+		},
+	}
+}
+
+func (r *dremioReflection) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Reflection resource - manages raw and aggregation Reflections on a dataset",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the Reflection",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Reflection",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of Reflection: `RAW` or `AGGREGATION`. Changing the type forces a new Reflection to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("RAW", "AGGREGATION"),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the table or view the Reflection is based on. Changing the dataset forces a new Reflection to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Reflection is enabled and available to accelerate queries. Default is true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"display_fields": reflectionFieldSchema("Fields included in a raw Reflection. Required for `RAW` Reflections."),
+			"dimension_fields": schema.ListNestedAttribute{
+				MarkdownDescription: "Dimension fields of an aggregation Reflection",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the dataset field",
+							Required:            true,
+						},
+						"granularity": schema.StringAttribute{
+							MarkdownDescription: "Grouping granularity for date and time fields: `DATE` or `NORMAL`. Dremio uses `DATE` when not set.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("DATE", "NORMAL"),
+							},
+						},
+					},
+				},
+			},
+			"measure_fields": schema.ListNestedAttribute{
+				MarkdownDescription: "Measure fields of an aggregation Reflection",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the dataset field",
+							Required:            true,
+						},
+						"measure_type_list": schema.ListAttribute{
+							MarkdownDescription: "Aggregations computed for the field: `SUM`, `COUNT`, `MIN`, `MAX`, `AVG` or `APPROX_COUNT_DISTINCT`. Dremio picks defaults based on the field type when not set.",
+							Optional:            true,
+							Computed:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(
+									stringvalidator.OneOf("SUM", "COUNT", "MIN", "MAX", "AVG", "APPROX_COUNT_DISTINCT"),
+								),
+							},
+						},
+					},
+				},
+			},
+			"distribution_fields": reflectionFieldSchema("Fields used to distribute the Reflection data across executors"),
+			"partition_fields":    reflectionFieldSchema("Fields used to horizontally partition the Reflection data"),
+			"sort_fields":         reflectionFieldSchema("Fields used to sort the Reflection data"),
+			"partition_distribution_strategy": schema.StringAttribute{
+				MarkdownDescription: "Partition distribution strategy: `CONSOLIDATED` or `STRIPED`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("CONSOLIDATED", "STRIPED"),
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Version tag for optimistic concurrency control. This value changes with every update.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the Reflection was created (UTC)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the Reflection was last updated (UTC)",
+				Computed:            true,
+			},
+			"current_size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Size of the latest Reflection data in bytes",
+				Computed:            true,
+			},
+			"total_size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Size of all Reflection data that has not been pruned, in bytes",
+				Computed:            true,
+			},
+			"status": schema.SingleNestedAttribute{
+				MarkdownDescription: "Status of the Reflection",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"config": schema.StringAttribute{
+						MarkdownDescription: "Configuration status (OK, INVALID)",
+						Computed:            true,
+					},
+					"refresh": schema.StringAttribute{
+						MarkdownDescription: "Refresh status (GIVEN_UP, MANUAL, RUNNING, SCHEDULED)",
+						Computed:            true,
+					},
+					"availability": schema.StringAttribute{
+						MarkdownDescription: "Availability status (NONE, EXPIRED, AVAILABLE)",
+						Computed:            true,
+					},
+					"combined_status": schema.StringAttribute{
+						MarkdownDescription: "Combined status of the Reflection, e.g. CAN_ACCELERATE, REFRESHING or FAILED",
+						Computed:            true,
+					},
+					"failure_count": schema.Int64Attribute{
+						MarkdownDescription: "Number of consecutive refresh failures",
+						Computed:            true,
+					},
+					"last_data_fetch": schema.StringAttribute{
+						MarkdownDescription: "Date and time the Reflection data was last refreshed (UTC)",
+						Computed:            true,
+					},
+					"expires_at": schema.StringAttribute{
+						MarkdownDescription: "Date and time the Reflection data expires (UTC)",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioReflection) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioReflectionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := r.parseResourceToRequestBody(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Make API request
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/reflection", reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create Reflection, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	reflectionResp := r.parseReflectionResponse(api_resp.Body, &resp.Diagnostics)
+	if reflectionResp == nil {
+		return
+	}
+
+	// Update state with response data
+	r.fromResponseToState(ctx, reflectionResp, &data, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created a Reflection resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioReflection) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioReflectionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	api_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/reflection/%s", id), nil)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Reflection %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read Reflection, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	reflectionResp := r.parseReflectionResponse(api_resp.Body, &resp.Diagnostics)
+	if reflectionResp == nil {
+		return
+	}
+
+	// Configuration fields are refreshed as well so that changes made in the UI show up as drift
+	state.Name = types.StringValue(reflectionResp.Name)
+	state.Type = types.StringValue(reflectionResp.Type)
+	state.DatasetID = types.StringValue(reflectionResp.DatasetID)
+	r.fromResponseToState(ctx, reflectionResp, &state, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioReflection) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioReflectionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state to retrieve the tag (computed field)
+	var state models.DremioReflectionModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createBody := r.parseResourceToRequestBody(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set ID and Tag for optimistic concurrency control
+	// Tag comes from state (not plan) because it's a computed field
+	id := state.ID.ValueString()
+	reqBody := &models.ReflectionUpdateRequest{
+		ID:                            id,
+		Tag:                           state.Tag.ValueString(),
+		Type:                          createBody.Type,
+		Name:                          createBody.Name,
+		DatasetID:                     createBody.DatasetID,
+		Enabled:                       createBody.Enabled,
+		EntityType:                    createBody.EntityType,
+		DisplayFields:                 createBody.DisplayFields,
+		DimensionFields:               createBody.DimensionFields,
+		MeasureFields:                 createBody.MeasureFields,
+		DistributionFields:            createBody.DistributionFields,
+		PartitionFields:               createBody.PartitionFields,
+		SortFields:                    createBody.SortFields,
+		PartitionDistributionStrategy: createBody.PartitionDistributionStrategy,
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reflection update request with ID: %s, and Tag: %s", id, reqBody.Tag))
+
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/reflection/%s", id), reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update Reflection, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	reflectionResp := r.parseReflectionResponse(api_resp.Body, &resp.Diagnostics)
+	if reflectionResp == nil {
+		return
+	}
+
+	// Update state with response data
+	r.fromResponseToState(ctx, reflectionResp, &plan, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "updated a Reflection resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioReflection) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioReflectionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "DELETE", fmt.Sprintf("/reflection/%s", id), nil)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Reflection %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete Reflection, got error: %s", err),
+		)
+		return
+	}
+}
+
+// parseResourceToRequestBody converts the Terraform plan to a Reflection create request
+func (r *dremioReflection) parseResourceToRequestBody(ctx context.Context, data *models.DremioReflectionModel, diags *diag.Diagnostics) *models.ReflectionCreateRequest {
+	reqBody := &models.ReflectionCreateRequest{
+		Type:       data.Type.ValueString(),
+		Name:       data.Name.ValueString(),
+		DatasetID:  data.DatasetID.ValueString(),
+		Enabled:    data.Enabled.ValueBool(),
+		EntityType: "reflection",
+	}
+
+	if !data.PartitionDistributionStrategy.IsNull() && !data.PartitionDistributionStrategy.IsUnknown() {
+		reqBody.PartitionDistributionStrategy = data.PartitionDistributionStrategy.ValueString()
+	}
+
+	displayNames, d := helpers.ConvertReflectionFieldNamesFromTerraform(ctx, data.DisplayFields)
+	diags.Append(d...)
+	for _, name := range displayNames {
+		reqBody.DisplayFields = append(reqBody.DisplayFields, models.ReflectionDisplayField{Name: name})
+	}
+
+	reqBody.DimensionFields, d = helpers.ConvertReflectionDimensionFieldsFromTerraform(ctx, data.DimensionFields)
+	diags.Append(d...)
+
+	reqBody.MeasureFields, d = helpers.ConvertReflectionMeasureFieldsFromTerraform(ctx, data.MeasureFields)
+	diags.Append(d...)
+
+	distributionNames, d := helpers.ConvertReflectionFieldNamesFromTerraform(ctx, data.DistributionFields)
+	diags.Append(d...)
+	for _, name := range distributionNames {
+		reqBody.DistributionFields = append(reqBody.DistributionFields, models.ReflectionDistributionField{Name: name})
+	}
+
+	partitionNames, d := helpers.ConvertReflectionFieldNamesFromTerraform(ctx, data.PartitionFields)
+	diags.Append(d...)
+	for _, name := range partitionNames {
+		reqBody.PartitionFields = append(reqBody.PartitionFields, models.ReflectionPartitionField{Name: name})
+	}
+
+	sortNames, d := helpers.ConvertReflectionFieldNamesFromTerraform(ctx, data.SortFields)
+	diags.Append(d...)
+	for _, name := range sortNames {
+		reqBody.SortFields = append(reqBody.SortFields, models.ReflectionSortField{Name: name})
+	}
+
+	return reqBody
+}
+
+// parseReflectionResponse reads and decodes a Reflection from an API response body
+func (r *dremioReflection) parseReflectionResponse(body io.Reader, diags *diag.Diagnostics) *models.ReflectionResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var reflectionResp models.ReflectionResponse
+	if err := json.Unmarshal(resp_body, &reflectionResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &reflectionResp
+}
+
+func (r *dremioReflection) fromResponseToState(ctx context.Context, reflectionResp *models.ReflectionResponse, state *models.DremioReflectionModel, diags *diag.Diagnostics) {
+	state.ID = types.StringValue(reflectionResp.ID)
+	state.Tag = types.StringValue(reflectionResp.Tag)
+	state.Enabled = types.BoolValue(reflectionResp.Enabled)
+	state.CurrentSizeBytes = types.Int64Value(reflectionResp.CurrentSizeBytes)
+	state.TotalSizeBytes = types.Int64Value(reflectionResp.TotalSizeBytes)
+
+	if reflectionResp.PartitionDistributionStrategy != "" {
+		state.PartitionDistributionStrategy = types.StringValue(reflectionResp.PartitionDistributionStrategy)
+	} else {
+		state.PartitionDistributionStrategy = types.StringNull()
+	}
+	if reflectionResp.CreatedAt != "" {
+		state.CreatedAt = types.StringValue(reflectionResp.CreatedAt)
+	} else {
+		state.CreatedAt = types.StringNull()
+	}
+	if reflectionResp.UpdatedAt != "" {
+		state.UpdatedAt = types.StringValue(reflectionResp.UpdatedAt)
+	} else {
+		state.UpdatedAt = types.StringNull()
+	}
+
+	var d diag.Diagnostics
+
+	displayNames := make([]string, 0, len(reflectionResp.DisplayFields))
+	for _, field := range reflectionResp.DisplayFields {
+		displayNames = append(displayNames, field.Name)
+	}
+	state.DisplayFields, d = helpers.ConvertReflectionFieldNamesToTerraform(ctx, displayNames, state.DisplayFields)
+	diags.Append(d...)
+
+	state.DimensionFields, d = helpers.ConvertReflectionDimensionFieldsToTerraform(ctx, reflectionResp.DimensionFields, state.DimensionFields)
+	diags.Append(d...)
+
+	state.MeasureFields, d = helpers.ConvertReflectionMeasureFieldsToTerraform(ctx, reflectionResp.MeasureFields, state.MeasureFields)
+	diags.Append(d...)
+
+	distributionNames := make([]string, 0, len(reflectionResp.DistributionFields))
+	for _, field := range reflectionResp.DistributionFields {
+		distributionNames = append(distributionNames, field.Name)
+	}
+	state.DistributionFields, d = helpers.ConvertReflectionFieldNamesToTerraform(ctx, distributionNames, state.DistributionFields)
+	diags.Append(d...)
+
+	partitionNames := make([]string, 0, len(reflectionResp.PartitionFields))
+	for _, field := range reflectionResp.PartitionFields {
+		partitionNames = append(partitionNames, field.Name)
+	}
+	state.PartitionFields, d = helpers.ConvertReflectionFieldNamesToTerraform(ctx, partitionNames, state.PartitionFields)
+	diags.Append(d...)
+
+	sortNames := make([]string, 0, len(reflectionResp.SortFields))
+	for _, field := range reflectionResp.SortFields {
+		sortNames = append(sortNames, field.Name)
+	}
+	state.SortFields, d = helpers.ConvertReflectionFieldNamesToTerraform(ctx, sortNames, state.SortFields)
+	diags.Append(d...)
+
+	state.Status, d = helpers.ConvertReflectionStatusToTerraform(ctx, reflectionResp.Status)
+	diags.Append(d...)
+}
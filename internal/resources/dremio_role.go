@@ -0,0 +1,283 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioRole{}
+	_ resource.ResourceWithConfigure   = &dremioRole{}
+	_ resource.ResourceWithImportState = &dremioRole{}
+)
+
+type dremioRole struct {
+	client *dremioClient.Client
+}
+
+func NewDremioRoleResource() resource.Resource {
+	return &dremioRole{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioRole) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *dremioRole) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioRole) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dremioRole) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Role resource - manages a role. Use `dremio_role_membership` to manage the members of the role.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the role",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the role",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the role",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the role (INTERNAL for roles created in Dremio, EXTERNAL for roles synchronized from an identity provider)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Version tag for optimistic concurrency control. This value changes with every update.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioRole) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioRoleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.RoleCreateRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+
+	// Roles are organization-wide on Dremio Cloud, not project-scoped
+	api_resp, err := r.client.RequestToDremio("POST", "/role", reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create role, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	roleResp := r.parseRoleResponse(api_resp.Body, &resp.Diagnostics)
+	if roleResp == nil {
+		return
+	}
+
+	r.fromResponseToState(roleResp, &data)
+
+	tflog.Trace(ctx, "created a role resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioRole) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioRoleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/role/%s", id), nil, true)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Role %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read role, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	roleResp := r.parseRoleResponse(api_resp.Body, &resp.Diagnostics)
+	if roleResp == nil {
+		return
+	}
+
+	// Refresh user-managed fields to detect changes made outside of Terraform
+	state.Name = types.StringValue(roleResp.Name)
+	state.Description = optionalString(roleResp.Description, state.Description)
+	r.fromResponseToState(roleResp, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioRole) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioRoleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state to retrieve the tag (computed field)
+	var state models.DremioRoleModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	reqBody := &models.RoleUpdateRequest{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Tag:         state.Tag.ValueString(),
+	}
+
+	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/role/%s", id), reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update role, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	roleResp := r.parseRoleResponse(api_resp.Body, &resp.Diagnostics)
+	if roleResp == nil {
+		return
+	}
+
+	r.fromResponseToState(roleResp, &plan)
+
+	tflog.Trace(ctx, "updated a role resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioRole) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioRoleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/role/%s", id), nil, true)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Role %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete role, got error: %s", err),
+		)
+		return
+	}
+}
+
+// parseRoleResponse reads and decodes a role from an API response body
+func (r *dremioRole) parseRoleResponse(body io.Reader, diags *diag.Diagnostics) *models.RoleResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var roleResp models.RoleResponse
+	if err := json.Unmarshal(resp_body, &roleResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &roleResp
+}
+
+func (r *dremioRole) fromResponseToState(roleResp *models.RoleResponse, state *models.DremioRoleModel) {
+	state.ID = types.StringValue(roleResp.ID)
+	state.Tag = types.StringValue(roleResp.Tag)
+
+	if roleResp.Type != "" {
+		state.Type = types.StringValue(roleResp.Type)
+	} else {
+		state.Type = types.StringNull()
+	}
+}
@@ -0,0 +1,331 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioRoleMembership{}
+	_ resource.ResourceWithConfigure   = &dremioRoleMembership{}
+	_ resource.ResourceWithImportState = &dremioRoleMembership{}
+)
+
+type dremioRoleMembership struct {
+	client *dremioClient.Client
+}
+
+func NewDremioRoleMembershipResource() resource.Resource {
+	return &dremioRoleMembership{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioRoleMembership) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_membership"
+}
+
+func (r *dremioRoleMembership) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioRoleMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by role ID; both id and role_id point to the role
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), req.ID)...)
+}
+
+func (r *dremioRoleMembership) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Role Membership resource - authoritatively manages the users that are direct members of a role. " +
+			"Users that are members of the role but not listed in `user_ids` are removed from the role. Role members of the role are left untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the membership (same as `role_id`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the role whose members are managed",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the users that must be direct members of the role",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioRoleMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioRoleMembershipModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := data.RoleID.ValueString()
+
+	var wanted []string
+	resp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &wanted, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The membership is authoritative, so users already in the role but not in the plan are removed
+	current, err := r.listUserMembers(roleID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read members of role %s, got error: %s", roleID, err),
+		)
+		return
+	}
+
+	if err := r.updateMembers(roleID, wanted, current); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update members of role %s, got error: %s", roleID, err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(roleID)
+
+	tflog.Trace(ctx, "created a role membership resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioRoleMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioRoleMembershipModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := state.RoleID.ValueString()
+
+	current, err := r.listUserMembers(roleID)
+	if err != nil {
+		// If the role is gone, the membership is gone with it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Role %s not found, removing membership from state", roleID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read members of role %s, got error: %s", roleID, err),
+		)
+		return
+	}
+
+	userIDs := make([]attr.Value, 0, len(current))
+	for _, id := range current {
+		userIDs = append(userIDs, types.StringValue(id))
+	}
+	userSet, d := types.SetValue(types.StringType, userIDs)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(roleID)
+	state.UserIDs = userSet
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioRoleMembership) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioRoleMembershipModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.DremioRoleMembershipModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := state.RoleID.ValueString()
+
+	var wanted, current []string
+	resp.Diagnostics.Append(plan.UserIDs.ElementsAs(ctx, &wanted, false)...)
+	resp.Diagnostics.Append(state.UserIDs.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updateMembers(roleID, wanted, current); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update members of role %s, got error: %s", roleID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(roleID)
+
+	tflog.Trace(ctx, "updated a role membership resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioRoleMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioRoleMembershipModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := state.RoleID.ValueString()
+
+	var current []string
+	resp.Diagnostics.Append(state.UserIDs.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only remove the users managed by this resource
+	if err := r.updateMembers(roleID, nil, current); err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Role %s already deleted", roleID))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to remove members of role %s, got error: %s", roleID, err),
+		)
+		return
+	}
+}
+
+// listUserMembers returns the IDs of the users that are direct members of a role, following pagination
+func (r *dremioRoleMembership) listUserMembers(roleID string) ([]string, error) {
+	var userIDs []string
+	pageToken := ""
+
+	for {
+		endpoint := fmt.Sprintf("/role/%s/member", roleID)
+		if pageToken != "" {
+			endpoint += "?pageToken=" + url.QueryEscape(pageToken)
+		}
+
+		api_resp, err := r.client.RequestToDremio("GET", endpoint, nil, true)
+		if err != nil {
+			return nil, err
+		}
+
+		page, err := parseRoleMembersResponse(api_resp.Body)
+		api_resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range page.Data {
+			if member.Type == "USER" {
+				userIDs = append(userIDs, member.ID)
+			}
+		}
+
+		if page.NextPageToken == "" {
+			return userIDs, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// updateMembers adds the users in wanted that are not in current, and removes the users in current that are not in wanted
+func (r *dremioRoleMembership) updateMembers(roleID string, wanted []string, current []string) error {
+	reqBody := &models.RoleMembersUpdateRequest{
+		Add:    setDifference(wanted, current),
+		Remove: setDifference(current, wanted),
+	}
+	if len(reqBody.Add) == 0 && len(reqBody.Remove) == 0 {
+		return nil
+	}
+
+	api_resp, err := r.client.RequestToDremio("PATCH", fmt.Sprintf("/role/%s/member", roleID), reqBody, true)
+	if err != nil {
+		return err
+	}
+	api_resp.Body.Close()
+	return nil
+}
+
+// parseRoleMembersResponse reads and decodes a page of role members from an API response body
+func parseRoleMembersResponse(body io.Reader) (*models.RoleMembersResponse, error) {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body: %w", err)
+	}
+
+	var page models.RoleMembersResponse
+	if err := json.Unmarshal(resp_body, &page); err != nil {
+		return nil, fmt.Errorf("unable to parse response: %w", err)
+	}
+	return &page, nil
+}
+
+// setDifference returns the values of a that are not in b
+func setDifference(a []string, b []string) []string {
+	inB := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		inB[v] = struct{}{}
+	}
+
+	var diff []string
+	for _, v := range a {
+		if _, ok := inB[v]; !ok {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,283 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioRowAccessPolicy{}
+	_ resource.ResourceWithConfigure   = &dremioRowAccessPolicy{}
+	_ resource.ResourceWithImportState = &dremioRowAccessPolicy{}
+)
+
+type dremioRowAccessPolicy struct {
+	client *dremioClient.Client
+}
+
+func NewDremioRowAccessPolicyResource() resource.Resource {
+	return &dremioRowAccessPolicy{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioRowAccessPolicy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_row_access_policy"
+}
+
+func (r *dremioRowAccessPolicy) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioRowAccessPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID, optionally prefixed with the project ID, and save to dataset_id attribute
+	importStateInProject(ctx, "dataset_id", req, resp)
+}
+
+// Schema defines the schema for the resource.
+func (r *dremioRowAccessPolicy) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Row Access Policy resource - filters the rows of a table or view with a UDF returning a BOOLEAN. " +
+			"A dataset has at most one row access policy. Changing any argument drops the policy and adds it again.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the policy, the same as `dataset_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the table or view the policy applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"udf_path": schema.ListAttribute{
+				MarkdownDescription: "Path of the UDF that returns whether a row is visible, e.g. `dremio_udf.region_filter.path`",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"columns": schema.ListAttribute{
+				MarkdownDescription: "Columns of the dataset passed to the UDF, in the order of its arguments",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"dataset_path": schema.ListAttribute{
+				MarkdownDescription: "Path of the table or view the policy applies to",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioRowAccessPolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioRowAccessPolicyModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.ProjectID.ValueString()
+	dataset, err := getPolicyDataset(r.client, projectID, data.DatasetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read the dataset of the row access policy, got error: %s", err),
+		)
+		return
+	}
+
+	policy := r.policyFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sqlReq := models.SQLRequest{SQL: fmt.Sprintf("ALTER %s %s ADD ROW ACCESS POLICY %s", datasetKeyword(dataset), quoteSQLPath(dataset.Path), policy.sql())}
+	if _, err := runSQLJob(ctx, r.client, projectID, sqlReq, policyStatementTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to add row access policy, got error: %s", err),
+		)
+		return
+	}
+
+	data.ID = data.DatasetID
+	datasetPath, diags := types.ListValueFrom(ctx, types.StringType, dataset.Path)
+	resp.Diagnostics.Append(diags...)
+	data.DatasetPath = datasetPath
+
+	tflog.Trace(ctx, "created a row access policy resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioRowAccessPolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioRowAccessPolicyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	datasetID := state.DatasetID.ValueString()
+	dataset, err := getPolicyDataset(r.client, projectID, datasetID)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Dataset %s not found, removing row access policy from state", datasetID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read the dataset of the row access policy, got error: %s", err),
+		)
+		return
+	}
+
+	definition, err := showCreateDataset(ctx, r.client, projectID, dataset)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read the definition of dataset %s, got error: %s", datasetID, err),
+		)
+		return
+	}
+
+	actual := parseRowAccessPolicy(definition)
+	if actual == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Dataset %s has no row access policy, removing from state", datasetID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Keep the configured spelling when Dremio reports the same policy, so that case changes are not drift
+	if state.UDFPath.IsNull() || !r.policyFromModel(ctx, &state, &resp.Diagnostics).equalFold(*actual) {
+		udfPath, diags := types.ListValueFrom(ctx, types.StringType, actual.Function)
+		resp.Diagnostics.Append(diags...)
+		columns, diags := types.ListValueFrom(ctx, types.StringType, actual.Arguments)
+		resp.Diagnostics.Append(diags...)
+		state.UDFPath = udfPath
+		state.Columns = columns
+	}
+
+	state.ID = state.DatasetID
+	datasetPath, diags := types.ListValueFrom(ctx, types.StringType, dataset.Path)
+	resp.Diagnostics.Append(diags...)
+	state.DatasetPath = datasetPath
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called with changes to the policy, since every argument forces a new resource.
+func (r *dremioRowAccessPolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioRowAccessPolicyModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioRowAccessPolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioRowAccessPolicyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	datasetID := state.DatasetID.ValueString()
+	dataset, err := getPolicyDataset(r.client, projectID, datasetID)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Dataset %s already deleted", datasetID))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read the dataset of the row access policy, got error: %s", err),
+		)
+		return
+	}
+
+	policy := r.policyFromModel(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sqlReq := models.SQLRequest{SQL: fmt.Sprintf("ALTER %s %s DROP ROW ACCESS POLICY %s", datasetKeyword(dataset), quoteSQLPath(dataset.Path), policy.sql())}
+	if _, err := runSQLJob(ctx, r.client, projectID, sqlReq, policyStatementTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to drop row access policy, got error: %s", err),
+		)
+		return
+	}
+}
+
+// policyFromModel returns the policy described by the Terraform model
+func (r *dremioRowAccessPolicy) policyFromModel(ctx context.Context, data *models.DremioRowAccessPolicyModel, diags *diag.Diagnostics) datasetPolicy {
+	var policy datasetPolicy
+	diags.Append(data.UDFPath.ElementsAs(ctx, &policy.Function, false)...)
+	diags.Append(data.Columns.ElementsAs(ctx, &policy.Arguments, false)...)
+	return policy
+}
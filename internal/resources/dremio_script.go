@@ -0,0 +1,385 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioScript{}
+	_ resource.ResourceWithConfigure   = &dremioScript{}
+	_ resource.ResourceWithImportState = &dremioScript{}
+)
+
+type dremioScript struct {
+	client *dremioClient.Client
+}
+
+func NewDremioScriptResource() resource.Resource {
+	return &dremioScript{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioScript) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script"
+}
+
+func (r *dremioScript) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_script"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioScript) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID, optionally prefixed with the project ID, and save to id attribute
+	importStateInProject(ctx, "id", req, resp)
+}
+
+func (r *dremioScript) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Script resource - manages a saved SQL script (Dremio Cloud only). Use `dremio_script_grants` to share the script with users and roles.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the script",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the script. Must be unique among the scripts of the owner.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "SQL of the script",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"context": schema.ListAttribute{
+				MarkdownDescription: "Path where the SQL of the script runs, e.g. `[\"analytics\", \"staging\"]`",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "ID of the user who owns the script. Defaults to the user the provider authenticates as.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the script was created (UTC)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "ID of the user who created the script",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the script was last modified (UTC)",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "ID of the user who last modified the script",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioScript) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioScriptModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.ScriptCreateRequest{
+		Name:    data.Name.ValueString(),
+		Content: data.Content.ValueString(),
+	}
+	if !data.Owner.IsUnknown() {
+		reqBody.Owner = data.Owner.ValueString()
+	}
+	resp.Diagnostics.Append(data.Context.ElementsAs(ctx, &reqBody.Context, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/scripts", reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create script, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	scriptResp := r.parseScriptResponse(api_resp.Body, &resp.Diagnostics)
+	if scriptResp == nil {
+		return
+	}
+
+	r.fromResponseToState(ctx, scriptResp, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a script resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioScript) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioScriptModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	api_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/scripts/%s", id), nil)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Script %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read script, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	scriptResp := r.parseScriptResponse(api_resp.Body, &resp.Diagnostics)
+	if scriptResp == nil {
+		return
+	}
+
+	// Refresh user-managed fields to detect changes made outside of Terraform
+	state.Name = types.StringValue(scriptResp.Name)
+	state.Content = types.StringValue(scriptResp.Content)
+	r.fromResponseToState(ctx, scriptResp, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioScript) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioScriptModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.DremioScriptModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	reqBody := &models.ScriptUpdateRequest{
+		Name:    plan.Name.ValueString(),
+		Content: plan.Content.ValueString(),
+	}
+	// Only transfer ownership when the owner changed
+	if !plan.Owner.IsUnknown() && !plan.Owner.Equal(state.Owner) {
+		reqBody.Owner = plan.Owner.ValueString()
+	}
+	resp.Diagnostics.Append(plan.Context.ElementsAs(ctx, &reqBody.Context, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Always send the context, so that removing it from the configuration clears it
+	if reqBody.Context == nil {
+		reqBody.Context = []string{}
+	}
+
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PATCH", fmt.Sprintf("/scripts/%s", id), reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update script, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	scriptResp := r.parseScriptResponse(api_resp.Body, &resp.Diagnostics)
+	if scriptResp == nil {
+		return
+	}
+
+	r.fromResponseToState(ctx, scriptResp, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated a script resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioScript) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioScriptModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	// The batch delete endpoint reports per-script failures instead of a single status code
+	reqBody := &models.ScriptBatchDeleteRequest{
+		IDs: []string{id},
+	}
+	api_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "POST", "/scripts:batchDelete", reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete script, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	resp_body, err := io.ReadAll(api_resp.Body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return
+	}
+
+	var deleteResp models.ScriptBatchDeleteResponse
+	if len(resp_body) > 0 {
+		if err := json.Unmarshal(resp_body, &deleteResp); err != nil {
+			resp.Diagnostics.AddError(
+				"Parse Error",
+				fmt.Sprintf("Unable to parse response: %s", err),
+			)
+			return
+		}
+	}
+
+	if len(deleteResp.NotFoundIDs) > 0 {
+		tflog.Warn(ctx, fmt.Sprintf("Script %s already deleted", id))
+	}
+	if len(deleteResp.UnauthorizedIDs) > 0 {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete script %s: not authorized", id),
+		)
+		return
+	}
+	if len(deleteResp.OtherErrorIDs) > 0 {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete script %s: Dremio reported an error for ID(s) %s", id, strings.Join(deleteResp.OtherErrorIDs, ", ")),
+		)
+		return
+	}
+}
+
+// parseScriptResponse reads and decodes a script from an API response body
+func (r *dremioScript) parseScriptResponse(body io.Reader, diags *diag.Diagnostics) *models.ScriptResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var scriptResp models.ScriptResponse
+	if err := json.Unmarshal(resp_body, &scriptResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &scriptResp
+}
+
+func (r *dremioScript) fromResponseToState(ctx context.Context, scriptResp *models.ScriptResponse, state *models.DremioScriptModel, diags *diag.Diagnostics) {
+	state.ID = types.StringValue(scriptResp.ID)
+	state.Owner = types.StringValue(scriptResp.Owner)
+	state.CreatedAt = types.StringValue(scriptResp.CreatedAt)
+	state.CreatedBy = types.StringValue(scriptResp.CreatedBy)
+	state.ModifiedAt = types.StringValue(scriptResp.ModifiedAt)
+	state.ModifiedBy = types.StringValue(scriptResp.ModifiedBy)
+
+	// Keep context null when it is not configured and Dremio reports an empty context
+	if len(scriptResp.Context) == 0 && state.Context.IsNull() {
+		return
+	}
+	contextList, d := types.ListValueFrom(ctx, types.StringType, append([]string{}, scriptResp.Context...))
+	diags.Append(d...)
+	state.Context = contextList
+}
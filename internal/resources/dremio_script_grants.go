@@ -0,0 +1,315 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioScriptGrants{}
+	_ resource.ResourceWithConfigure   = &dremioScriptGrants{}
+	_ resource.ResourceWithImportState = &dremioScriptGrants{}
+)
+
+// scriptPrivileges lists the privileges that can be granted on a script
+var scriptPrivileges = []string{"VIEW", "MODIFY", "DELETE", "MANAGE_GRANTS"}
+
+type dremioScriptGrants struct {
+	client *dremioClient.Client
+}
+
+func NewDremioScriptGrantsResource() resource.Resource {
+	return &dremioScriptGrants{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioScriptGrants) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script_grants"
+}
+
+func (r *dremioScriptGrants) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireCloud("dremio_script_grants"); err != nil {
+		resp.Diagnostics.AddError("Unsupported Dremio Type", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+// scriptGranteeSchema returns the schema of a set of script grantees
+func scriptGranteeSchema(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"grantee_id": schema.StringAttribute{
+					MarkdownDescription: "UUID of the user or role to grant privileges to.",
+					Required:            true,
+				},
+				"privileges": schema.SetAttribute{
+					MarkdownDescription: "Set of privileges to grant. Valid values: VIEW, MODIFY, DELETE, MANAGE_GRANTS.",
+					Required:            true,
+					ElementType:         types.StringType,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueStringsAre(stringvalidator.OneOf(scriptPrivileges...)),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *dremioScriptGrants) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the users and roles that can access a Dremio script (Dremio Cloud only).\n\n**Important:** This resource manages ALL grants on the script. Existing grants not in the configuration are removed. When destroyed, it removes all grants from the script.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"script_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the script to manage grants for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": scriptGranteeSchema("Set of grants to users on the script."),
+			"roles": scriptGranteeSchema("Set of grants to roles on the script."),
+		},
+	}
+}
+
+func (r *dremioScriptGrants) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID, optionally prefixed with the project ID, and save to script_id attribute
+	importStateInProject(ctx, "script_id", req, resp)
+}
+
+// Create a new resource.
+func (r *dremioScriptGrants) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioScriptGrantsModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.putGrants(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created script grants resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioScriptGrants) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioScriptGrantsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scriptID := state.ScriptID.ValueString()
+
+	apiResp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/scripts/%s/grants", scriptID), nil)
+	if err != nil {
+		// If the script is not found (404), remove the grants from state so Terraform will recreate them
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Script %s not found, removing grants from state", scriptID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read script grants, got error: %s", err),
+		)
+		return
+	}
+	defer apiResp.Body.Close()
+
+	grantsResp := r.parseGrantsResponse(apiResp.Body, &resp.Diagnostics)
+	if grantsResp == nil {
+		return
+	}
+
+	r.fromResponseToState(ctx, grantsResp, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioScriptGrants) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioScriptGrantsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.putGrants(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated script grants resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioScriptGrants) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioScriptGrantsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scriptID := state.ScriptID.ValueString()
+
+	// There is no delete operation in the API, grants are removed by setting them to empty arrays
+	reqBody := models.ScriptGrantsUpdateRequest{
+		Users: []models.ScriptGrantee{},
+		Roles: []models.ScriptGrantee{},
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting grants for script: %s (setting to empty arrays)", scriptID))
+
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "PUT", fmt.Sprintf("/scripts/%s/grants", scriptID), reqBody)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Script %s already deleted", scriptID))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete script grants, got error: %s", err),
+		)
+		return
+	}
+}
+
+// putGrants replaces the grants of the script with the planned grants, then reads them back into data
+func (r *dremioScriptGrants) putGrants(ctx context.Context, data *models.DremioScriptGrantsModel, diags *diag.Diagnostics) {
+	scriptID := data.ScriptID.ValueString()
+
+	users, d := helpers.ConvertScriptGranteeSetFromTerraform(ctx, data.Users)
+	diags.Append(d...)
+	roles, d := helpers.ConvertScriptGranteeSetFromTerraform(ctx, data.Roles)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	reqBody := models.ScriptGrantsUpdateRequest{
+		Users: users,
+		Roles: roles,
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting grants for script: %s", scriptID))
+
+	apiResp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "PUT", fmt.Sprintf("/scripts/%s/grants", scriptID), reqBody)
+	if err != nil {
+		diags.AddError(
+			"Client Error", fmt.Sprintf("Unable to set script grants, got error: %s", err),
+		)
+		return
+	}
+	apiResp.Body.Close()
+
+	// Read the grants back, so that the state reflects what Dremio stored
+	getResp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "GET", fmt.Sprintf("/scripts/%s/grants", scriptID), nil)
+	if err != nil {
+		diags.AddError(
+			"Client Error", fmt.Sprintf("Unable to read script grants, got error: %s", err),
+		)
+		return
+	}
+	defer getResp.Body.Close()
+
+	grantsResp := r.parseGrantsResponse(getResp.Body, diags)
+	if grantsResp == nil {
+		return
+	}
+
+	r.fromResponseToState(ctx, grantsResp, data, diags)
+}
+
+// parseGrantsResponse reads and decodes script grants from an API response body
+func (r *dremioScriptGrants) parseGrantsResponse(body io.Reader, diags *diag.Diagnostics) *models.ScriptGrantsResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var grantsResp models.ScriptGrantsResponse
+	if err := json.Unmarshal(resp_body, &grantsResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &grantsResp
+}
+
+func (r *dremioScriptGrants) fromResponseToState(ctx context.Context, grantsResp *models.ScriptGrantsResponse, state *models.DremioScriptGrantsModel, diags *diag.Diagnostics) {
+	// Keep unset grantee sets null when Dremio reports no grants for them
+	if len(grantsResp.Users) > 0 || !state.Users.IsNull() {
+		users, d := helpers.ConvertScriptGranteesToTerraform(ctx, grantsResp.Users)
+		diags.Append(d...)
+		state.Users = users
+	}
+	if len(grantsResp.Roles) > 0 || !state.Roles.IsNull() {
+		roles, d := helpers.ConvertScriptGranteesToTerraform(ctx, grantsResp.Roles)
+		diags.Append(d...)
+		state.Roles = roles
+	}
+}
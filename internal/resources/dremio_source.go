@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -73,6 +72,7 @@ func (r *dremioSource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 		MarkdownDescription: "Dremio Source resource",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the source",
 				Computed:            true,
@@ -226,7 +226,7 @@ func (r *dremioSource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Make API request
-	api_resp, err := r.client.RequestToDremio("POST", "/catalog", reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/catalog", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to create source, got error: %s", err),
@@ -289,10 +289,10 @@ func (r *dremioSource) Read(ctx context.Context, req resource.ReadRequest, resp
 	id := state.ID.ValueString()
 
 	var sourceResp models.SourceResponse
-	source_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s", id), nil)
+	source_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s", id), nil)
 	if err != nil {
 		// If resource is not found (404), remove it from state so Terraform will recreate it
-		if strings.Contains(err.Error(), "status 404") {
+		if dremioClient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Source %s not found, removing from state", id))
 			resp.State.RemoveResource(ctx)
 			return
@@ -360,7 +360,7 @@ func (r *dremioSource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	tflog.Debug(ctx, fmt.Sprintf("Source update request with ID: %s, and Tag: %s", id, reqBody.Tag))
 
-	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/catalog/%s", id), reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s", id), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update source, got error: %s", err),
@@ -409,7 +409,7 @@ func (r *dremioSource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	id := state.ID.ValueString()
-	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/catalog/%s", id), nil)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "DELETE", fmt.Sprintf("/catalog/%s", id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to delete source, got error: %s", err),
@@ -419,8 +419,8 @@ func (r *dremioSource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *dremioSource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve import ID, optionally prefixed with the project ID, and save to id attribute
+	importStateInProject(ctx, "id", req, resp)
 }
 
 // fromResponseToState updates the state with values from the API response.
@@ -0,0 +1,352 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioSpace{}
+	_ resource.ResourceWithConfigure   = &dremioSpace{}
+	_ resource.ResourceWithImportState = &dremioSpace{}
+)
+
+type dremioSpace struct {
+	client *dremioClient.Client
+}
+
+func NewDremioSpaceResource() resource.Resource {
+	return &dremioSpace{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioSpace) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space"
+}
+
+func (r *dremioSpace) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioSpace) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID, optionally prefixed with the project ID, and save to id attribute
+	importStateInProject(ctx, "id", req, resp)
+}
+
+func (r *dremioSpace) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioSpaceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "DELETE", fmt.Sprintf("/catalog/%s", id), nil)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Space %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete space, got error: %s", err),
+		)
+		return
+	}
+}
+
+func (r *dremioSpace) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio Space resource - manages a space, a top-level container for views and folders",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the space",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the space. Changing the name forces a new space to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[^/:[\]@"]*$`),
+						"space name must not contain the characters: /, :, [, ], @, \"",
+					),
+				},
+			},
+			"access_control_list": schema.SingleNestedAttribute{
+				MarkdownDescription: "User and role access settings",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"users": schema.ListNestedAttribute{
+						MarkdownDescription: "List of user access controls",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									MarkdownDescription: "User ID",
+									Required:            true,
+								},
+								"permissions": schema.ListAttribute{
+									MarkdownDescription: "List of permissions",
+									Required:            true,
+									ElementType:         types.StringType,
+								},
+							},
+						},
+					},
+					"roles": schema.ListNestedAttribute{
+						MarkdownDescription: "List of role access controls",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									MarkdownDescription: "Role ID",
+									Required:            true,
+								},
+								"permissions": schema.ListAttribute{
+									MarkdownDescription: "List of permissions",
+									Required:            true,
+									ElementType:         types.StringType,
+								},
+							},
+						},
+					},
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Version tag for optimistic concurrency control. This value changes with every update.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Date and time the space was created (UTC)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioSpace) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioSpaceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.SpaceCreateRequest{
+		EntityType: "space",
+		Name:       data.Name.ValueString(),
+	}
+
+	var aclDiags diag.Diagnostics
+	reqBody.AccessControlList, aclDiags = helpers.ConvertACLFromTerraform(ctx, data.AccessControlList)
+	resp.Diagnostics.Append(aclDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Make API request
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/catalog", reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create space, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	spaceResp := r.parseSpaceResponse(api_resp.Body, &resp.Diagnostics)
+	if spaceResp == nil {
+		return
+	}
+
+	// Update state with response data
+	r.fromResponseToState(ctx, spaceResp, &data, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created a space resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioSpace) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioSpaceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	space_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s", id), nil)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Space %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read space, got error: %s", err),
+		)
+		return
+	}
+	defer space_resp.Body.Close()
+
+	spaceResp := r.parseSpaceResponse(space_resp.Body, &resp.Diagnostics)
+	if spaceResp == nil {
+		return
+	}
+
+	// Update state with response data
+	state.Name = types.StringValue(spaceResp.Name)
+	r.fromResponseToState(ctx, spaceResp, &state, &resp.Diagnostics)
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioSpace) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioSpaceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state to retrieve the tag (computed field)
+	var state models.DremioSpaceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set ID and Tag for optimistic concurrency control
+	// Tag comes from state (not plan) because it's a computed field
+	id := state.ID.ValueString()
+	reqBody := &models.SpaceUpdateRequest{
+		EntityType: "space",
+		ID:         id,
+		Name:       plan.Name.ValueString(),
+		Tag:        state.Tag.ValueString(),
+	}
+
+	var aclDiags diag.Diagnostics
+	reqBody.AccessControlList, aclDiags = helpers.ConvertACLFromTerraform(ctx, plan.AccessControlList)
+	resp.Diagnostics.Append(aclDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Space update request with ID: %s, and Tag: %s", id, reqBody.Tag))
+
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s", id), reqBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update space, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	spaceResp := r.parseSpaceResponse(api_resp.Body, &resp.Diagnostics)
+	if spaceResp == nil {
+		return
+	}
+
+	// Update state with response data
+	r.fromResponseToState(ctx, spaceResp, &plan, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "updated a space resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// parseSpaceResponse reads and decodes a space from an API response body
+func (r *dremioSpace) parseSpaceResponse(body io.Reader, diags *diag.Diagnostics) *models.SpaceResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var spaceResp models.SpaceResponse
+	if err := json.Unmarshal(resp_body, &spaceResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &spaceResp
+}
+
+func (r *dremioSpace) fromResponseToState(ctx context.Context, spaceResp *models.SpaceResponse, state *models.DremioSpaceModel, diags *diag.Diagnostics) {
+	state.ID = types.StringValue(spaceResp.ID)
+	state.Tag = types.StringValue(spaceResp.Tag)
+
+	if spaceResp.CreatedAt != "" {
+		state.CreatedAt = types.StringValue(spaceResp.CreatedAt)
+	} else {
+		state.CreatedAt = types.StringNull()
+	}
+
+	// Access control list block - use helper function
+	var aclDiags diag.Diagnostics
+	state.AccessControlList, aclDiags = helpers.ConvertACLToTerraform(ctx, spaceResp.AccessControlList, state.AccessControlList)
+	diags.Append(aclDiags...)
+}
@@ -0,0 +1,423 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// sqlStatementDefaultTimeout is how long a statement may run when timeout is not set
+	sqlStatementDefaultTimeout = 10 * time.Minute
+	// sqlStatementReadRowLimit is the number of rows of read_sql kept in read_result
+	sqlStatementReadRowLimit = 100
+)
+
+var (
+	_ resource.Resource               = &dremioSQLStatement{}
+	_ resource.ResourceWithConfigure  = &dremioSQLStatement{}
+	_ resource.ResourceWithModifyPlan = &dremioSQLStatement{}
+)
+
+type dremioSQLStatement struct {
+	client *dremioClient.Client
+}
+
+func NewDremioSQLStatementResource() resource.Resource {
+	return &dremioSQLStatement{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioSQLStatement) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_statement"
+}
+
+func (r *dremioSQLStatement) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// requiresReplaceWithoutUpdateSQL replaces the resource when create_sql changes, unless update_sql
+// is set to apply the change in place
+func requiresReplaceWithoutUpdateSQL(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var updateSQL types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("update_sql"), &updateSQL)...)
+	resp.RequiresReplace = updateSQL.IsNull()
+}
+
+// Schema defines the schema for the resource.
+func (r *dremioSQLStatement) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio SQL Statement resource - manages an object that can only be created with SQL, such as a policy, a branch or a table created with `CREATE TABLE AS`. " +
+			"`create_sql` runs on create and `destroy_sql` on destroy. Write idempotent statements (e.g. `IF NOT EXISTS`), since a statement that failed halfway may be run again.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the job that ran `create_sql`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_sql": schema.StringAttribute{
+				MarkdownDescription: "SQL statement run when the resource is created. Changing it forces a new resource, unless `update_sql` is set.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceWithoutUpdateSQL,
+						"Changing create_sql forces a new resource unless update_sql is set.",
+						"Changing `create_sql` forces a new resource unless `update_sql` is set.",
+					),
+				},
+			},
+			"destroy_sql": schema.StringAttribute{
+				MarkdownDescription: "SQL statement run when the resource is destroyed. When not set, destroying the resource only removes it from the Terraform state.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"update_sql": schema.StringAttribute{
+				MarkdownDescription: "SQL statement run in place of a replacement when `create_sql` or `update_sql` change.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"read_sql": schema.StringAttribute{
+				MarkdownDescription: "Query run on every refresh to detect drift. It must return at least one row while the object exists. When it returns no rows, the resource is removed from the state and created again on the next apply. " +
+					"Changes to the rows it returns are only detected when `expected_result` is set.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"expected_result": schema.StringAttribute{
+				MarkdownDescription: "Rows `read_sql` must return, as a JSON array of objects, e.g. `jsonencode([{ policy = \"mask_ssn\" }])`. " +
+					"When `read_result` differs from it, the resource is replaced: `destroy_sql` and `create_sql` run again.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("read_sql")),
+				},
+			},
+			"context": schema.ListAttribute{
+				MarkdownDescription: "Path of the container the statements run in, used to resolve unqualified names",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for each statement to complete, as a Go duration string (e.g. `30s`, `1h`). The job is canceled when it expires. Defaults to `10m`.",
+				Optional:            true,
+			},
+			"job_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the job of the last statement run by `create_sql` or `update_sql`",
+				Computed:            true,
+			},
+			"read_result": schema.StringAttribute{
+				MarkdownDescription: "Rows returned by `read_sql`, as a JSON array of objects (up to 100 rows). Null when `read_sql` is not set. " +
+					"Without `expected_result`, a change of the rows does not change the plan; use it in checks and postconditions.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioSQLStatement) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioSQLStatementModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobID := r.runStatement(ctx, &data, "create_sql", data.CreateSQL.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue(jobID)
+	data.JobID = types.StringValue(jobID)
+
+	data.ReadResult = types.StringNull()
+	if !data.ReadSQL.IsNull() {
+		readResult, found := r.runReadSQL(ctx, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddWarning(
+				"read_sql Returned No Rows",
+				"create_sql completed, but read_sql returned no rows. The resource will be created again on the next apply unless read_sql is fixed.",
+			)
+		}
+		data.ReadResult = readResult
+		r.warnUnexpectedResult(&data, "create_sql", &resp.Diagnostics)
+	}
+
+	tflog.Trace(ctx, "created a SQL statement resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioSQLStatement) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioSQLStatementModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without read_sql, there is nothing to compare against
+	if state.ReadSQL.IsNull() {
+		state.ReadResult = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	readResult, found := r.runReadSQL(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("read_sql of SQL statement %s returned no rows, removing from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.ReadResult = readResult
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan replaces the resource when the rows returned by read_sql no longer match expected_result.
+func (r *dremioSQLStatement) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state models.DremioSQLStatementModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ExpectedResult.IsNull() || plan.ExpectedResult.IsUnknown() || state.ReadResult.IsNull() {
+		return
+	}
+
+	matches, err := jsonEqual(state.ReadResult.ValueString(), plan.ExpectedResult.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_result"),
+			"Invalid Expected Result",
+			fmt.Sprintf("expected_result must be a JSON array of objects: %s", err),
+		)
+		return
+	}
+	if matches {
+		return
+	}
+
+	// read_result changes from the refreshed rows to the rows of the new object, which requires a replacement
+	plan.ReadResult = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("read_result"))
+}
+
+func (r *dremioSQLStatement) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioSQLStatementModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.DremioSQLStatementModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to destroy_sql, read_sql, expected_result, context or timeout alone are only saved in the state
+	plan.JobID = state.JobID
+	if !plan.UpdateSQL.IsNull() && (!plan.CreateSQL.Equal(state.CreateSQL) || !plan.UpdateSQL.Equal(state.UpdateSQL)) {
+		jobID := r.runStatement(ctx, &plan, "update_sql", plan.UpdateSQL.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.JobID = types.StringValue(jobID)
+	}
+
+	plan.ReadResult = types.StringNull()
+	if !plan.ReadSQL.IsNull() {
+		readResult, _ := r.runReadSQL(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.ReadResult = readResult
+		r.warnUnexpectedResult(&plan, "update_sql", &resp.Diagnostics)
+	}
+
+	tflog.Trace(ctx, "updated a SQL statement resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioSQLStatement) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioSQLStatementModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DestroySQL.IsNull() {
+		tflog.Warn(ctx, fmt.Sprintf("SQL statement %s has no destroy_sql, only removing it from state", state.ID.ValueString()))
+		return
+	}
+
+	r.runStatement(ctx, &state, "destroy_sql", state.DestroySQL.ValueString(), &resp.Diagnostics)
+}
+
+// runStatement runs a SQL statement and waits for its job to complete. It returns the job ID,
+// and reports a failed job with the error details from Dremio.
+func (r *dremioSQLStatement) runStatement(ctx context.Context, data *models.DremioSQLStatementModel, attribute, sql string, diags *diag.Diagnostics) string {
+	timeout := sqlStatementDefaultTimeout
+	if !data.Timeout.IsNull() {
+		value, err := time.ParseDuration(data.Timeout.ValueString())
+		if err != nil || value <= 0 {
+			diags.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid Timeout",
+				fmt.Sprintf("The value %q is not a valid duration. It must be a positive Go duration string such as \"30s\".", data.Timeout.ValueString()),
+			)
+			return ""
+		}
+		timeout = value
+	}
+
+	sqlReq := models.SQLRequest{SQL: sql}
+	if !data.Context.IsNull() {
+		diags.Append(data.Context.ElementsAs(ctx, &sqlReq.Context, false)...)
+		if diags.HasError() {
+			return ""
+		}
+	}
+
+	jobID, err := runSQLJob(ctx, r.client, data.ProjectID.ValueString(), sqlReq, timeout)
+	if err != nil {
+		var jobErr *dremioClient.JobError
+		if errors.As(err, &jobErr) {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"SQL Statement Error",
+				fmt.Sprintf("Job %s running %s is %s.\n\n%s", jobErr.JobID, attribute, jobErr.JobState, jobErr.ErrorMessage),
+			)
+			return ""
+		}
+		diags.AddError(
+			"SQL Statement Error", fmt.Sprintf("Unable to run %s: %s", attribute, err),
+		)
+		return ""
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Ran %s as job %s", attribute, jobID))
+
+	return jobID
+}
+
+// runReadSQL runs read_sql and returns its rows as JSON, and whether it returned any row
+func (r *dremioSQLStatement) runReadSQL(ctx context.Context, data *models.DremioSQLStatementModel, diags *diag.Diagnostics) (types.String, bool) {
+	jobID := r.runStatement(ctx, data, "read_sql", data.ReadSQL.ValueString(), diags)
+	if diags.HasError() {
+		return types.StringNull(), false
+	}
+
+	results, err := r.client.GetJobResults(data.ProjectID.ValueString(), jobID, sqlStatementReadRowLimit)
+	if err != nil {
+		diags.AddError(
+			"Read Error", fmt.Sprintf("Unable to read the results of read_sql (job %s): %s", jobID, err),
+		)
+		return types.StringNull(), false
+	}
+
+	rowsJSON, err := json.Marshal(results.Rows)
+	if err != nil {
+		diags.AddError(
+			"JSON Conversion Error", fmt.Sprintf("Unable to convert the results of read_sql to JSON: %s", err),
+		)
+		return types.StringNull(), false
+	}
+
+	return types.StringValue(string(rowsJSON)), len(results.Rows) > 0
+}
+
+// warnUnexpectedResult warns when the rows read right after a statement ran do not match expected_result,
+// since the resource would then be replaced on every apply
+func (r *dremioSQLStatement) warnUnexpectedResult(data *models.DremioSQLStatementModel, attribute string, diags *diag.Diagnostics) {
+	if data.ExpectedResult.IsNull() || data.ReadResult.IsNull() {
+		return
+	}
+	if matches, err := jsonEqual(data.ReadResult.ValueString(), data.ExpectedResult.ValueString()); err == nil && matches {
+		return
+	}
+	diags.AddWarning(
+		"read_sql Returned Unexpected Rows",
+		fmt.Sprintf("%s completed, but the rows returned by read_sql do not match expected_result:\n\n%s\n\nThe resource will be replaced on the next apply unless the statements or expected_result are fixed.", attribute, data.ReadResult.ValueString()),
+	)
+}
+
+// jsonEqual reports whether two JSON documents hold the same value, ignoring formatting and key order
+func jsonEqual(a, b string) (bool, error) {
+	var valueA, valueB interface{}
+	if err := json.Unmarshal([]byte(a), &valueA); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(b), &valueB); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(valueA, valueB), nil
+}
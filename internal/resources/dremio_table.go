@@ -7,7 +7,6 @@ import (
 	"io"
 	"net/url"
 	"regexp"
-	"strings"
 
 	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
 	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
@@ -15,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -67,8 +65,8 @@ func (r *dremioTable) Configure(ctx context.Context, req resource.ConfigureReque
 }
 
 func (r *dremioTable) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve import ID, optionally prefixed with the project ID, and save to id attribute
+	importStateInProject(ctx, "id", req, resp)
 }
 
 func (r *dremioTable) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -80,7 +78,7 @@ func (r *dremioTable) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	id := state.ID.ValueString()
-	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/catalog/%s", id), nil)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "DELETE", fmt.Sprintf("/catalog/%s%s", id, catalogRefQuery(state.Ref)), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to delete table, got error: %s", err),
@@ -94,6 +92,7 @@ func (r *dremioTable) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 		MarkdownDescription: "Dremio Table resource",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the table",
 				Computed:            true,
@@ -267,6 +266,13 @@ func (r *dremioTable) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					},
 				},
 			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Branch of a versioned source (Arctic or Nessie) the table is created on. Defaults to the default branch of the source.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"tag": schema.StringAttribute{
 				MarkdownDescription: "Version tag for optimistic concurrency control. This value changes with every update.",
 				Computed:            true,
@@ -294,7 +300,7 @@ func (r *dremioTable) Create(ctx context.Context, req resource.CreateRequest, re
 	fileOrFolderID := url.QueryEscape(data.FileOrFolderID.ValueString())
 
 	// Make API request
-	api_resp, err := r.client.RequestToDremio("POST", fmt.Sprintf("/catalog/%s", fileOrFolderID), reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", fmt.Sprintf("/catalog/%s%s", fileOrFolderID, catalogRefQuery(data.Ref)), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to create table, got error: %s", err),
@@ -344,10 +350,10 @@ func (r *dremioTable) Read(ctx context.Context, req resource.ReadRequest, resp *
 	id := state.ID.ValueString()
 
 	var tableResp models.TableResponse
-	table_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s", id), nil)
+	table_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s%s", id, catalogRefQuery(state.Ref)), nil)
 	if err != nil {
 		// If resource is not found (404), remove it from state so Terraform will recreate it
-		if strings.Contains(err.Error(), "status 404") {
+		if dremioClient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Table %s not found, removing from state", id))
 			resp.State.RemoveResource(ctx)
 			return
@@ -413,7 +419,7 @@ func (r *dremioTable) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	tflog.Debug(ctx, fmt.Sprintf("Table update request with ID: %s, and Tag: %s", id, reqBody.Tag))
 
-	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/catalog/%s", id), reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s%s", id, catalogRefQuery(plan.Ref)), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update table, got error: %s", err),
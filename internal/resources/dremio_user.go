@@ -0,0 +1,327 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &dremioUser{}
+	_ resource.ResourceWithConfigure   = &dremioUser{}
+	_ resource.ResourceWithImportState = &dremioUser{}
+)
+
+type dremioUser struct {
+	client *dremioClient.Client
+}
+
+func NewDremioUserResource() resource.Resource {
+	return &dremioUser{}
+}
+
+// Metadata returns the resource type name.
+func (r *dremioUser) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *dremioUser) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*dremioClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *dremioClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dremioUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dremioUser) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Dremio User resource - manages a user of the organization (Dremio Cloud) or a local user (Dremio Software)",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the user",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "Username of the user. On Dremio Cloud this is the email address of the user.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "First name of the user",
+				Optional:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Last name of the user",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user. Only used for local users on Dremio Software; Dremio Cloud users sign in through the organization's identity providers.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is active",
+				Computed:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Version tag for optimistic concurrency control. This value changes with every update.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *dremioUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data models.DremioUserModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody := &models.UserCreateRequest{
+		UserName:  data.UserName.ValueString(),
+		FirstName: data.FirstName.ValueString(),
+		LastName:  data.LastName.ValueString(),
+		Email:     data.Email.ValueString(),
+		Password:  data.Password.ValueString(),
+	}
+
+	// Users are organization-wide on Dremio Cloud, not project-scoped
+	api_resp, err := r.client.RequestToDremio("POST", "/user", reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to create user, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	userResp := r.parseUserResponse(api_resp.Body, &resp.Diagnostics)
+	if userResp == nil {
+		return
+	}
+
+	r.fromResponseToState(userResp, &data)
+
+	tflog.Trace(ctx, "created a user resource")
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read resource information.
+func (r *dremioUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state models.DremioUserModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	api_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/user/%s", id), nil, true)
+	if err != nil {
+		// If resource is not found (404), remove it from state so Terraform will recreate it
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("User %s not found, removing from state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to read user, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	userResp := r.parseUserResponse(api_resp.Body, &resp.Diagnostics)
+	if userResp == nil {
+		return
+	}
+
+	// Refresh user-managed fields to detect changes made outside of Terraform
+	state.UserName = types.StringValue(responseUserName(userResp))
+	state.Email = types.StringValue(userResp.Email)
+	state.FirstName = optionalString(userResp.FirstName, state.FirstName)
+	state.LastName = optionalString(userResp.LastName, state.LastName)
+	r.fromResponseToState(userResp, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dremioUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.DremioUserModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state to retrieve the tag (computed field)
+	var state models.DremioUserModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	reqBody := &models.UserUpdateRequest{
+		UserName:  plan.UserName.ValueString(),
+		FirstName: plan.FirstName.ValueString(),
+		LastName:  plan.LastName.ValueString(),
+		Email:     plan.Email.ValueString(),
+		Tag:       state.Tag.ValueString(),
+	}
+	// Only send the password when it changed, so that unrelated updates do not reset it
+	if !plan.Password.Equal(state.Password) {
+		reqBody.Password = plan.Password.ValueString()
+	}
+
+	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/user/%s", id), reqBody, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to update user, got error: %s", err),
+		)
+		return
+	}
+	defer api_resp.Body.Close()
+
+	userResp := r.parseUserResponse(api_resp.Body, &resp.Diagnostics)
+	if userResp == nil {
+		return
+	}
+
+	r.fromResponseToState(userResp, &plan)
+
+	tflog.Trace(ctx, "updated a user resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dremioUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.DremioUserModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	path := fmt.Sprintf("/user/%s", id)
+	// Dremio Software requires the current version of the user to delete it
+	if !r.client.IsCloud() {
+		path += "?version=" + url.QueryEscape(state.Tag.ValueString())
+	}
+
+	_, err := r.client.RequestToDremio("DELETE", path, nil, true)
+	if err != nil {
+		if dremioClient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("User %s already deleted", id))
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error", fmt.Sprintf("Unable to delete user, got error: %s", err),
+		)
+		return
+	}
+}
+
+// parseUserResponse reads and decodes a user from an API response body
+func (r *dremioUser) parseUserResponse(body io.Reader, diags *diag.Diagnostics) *models.UserResponse {
+	resp_body, err := io.ReadAll(body)
+	if err != nil {
+		diags.AddError(
+			"Read Error",
+			fmt.Sprintf("Unable to read response body: %s", err),
+		)
+		return nil
+	}
+
+	var userResp models.UserResponse
+	if err := json.Unmarshal(resp_body, &userResp); err != nil {
+		diags.AddError(
+			"Parse Error",
+			fmt.Sprintf("Unable to parse response: %s", err),
+		)
+		return nil
+	}
+	return &userResp
+}
+
+func (r *dremioUser) fromResponseToState(userResp *models.UserResponse, state *models.DremioUserModel) {
+	state.ID = types.StringValue(userResp.ID)
+	state.Tag = types.StringValue(userResp.Tag)
+
+	// Users are active unless Dremio says otherwise
+	state.Active = types.BoolValue(userResp.Active == nil || *userResp.Active)
+}
+
+// responseUserName returns the username of a user, which Dremio reports either as name or userName
+func responseUserName(userResp *models.UserResponse) string {
+	if userResp.Name != "" {
+		return userResp.Name
+	}
+	return userResp.UserName
+}
+
+// optionalString returns the API value, or keeps the current value when the API omits an unset optional field
+func optionalString(apiValue string, current types.String) types.String {
+	if apiValue == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(apiValue)
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"regexp"
-	"strings"
 
 	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
 	"github.com/carlos-ffs/dremio-terraform-provider/internal/helpers"
@@ -14,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -66,8 +64,8 @@ func (r *dremioView) Configure(ctx context.Context, req resource.ConfigureReques
 }
 
 func (r *dremioView) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve import ID, optionally prefixed with the project ID, and save to id attribute
+	importStateInProject(ctx, "id", req, resp)
 }
 
 func (r *dremioView) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -79,7 +77,7 @@ func (r *dremioView) Delete(ctx context.Context, req resource.DeleteRequest, res
 	}
 
 	id := state.ID.ValueString()
-	_, err := r.client.RequestToDremio("DELETE", fmt.Sprintf("/catalog/%s", id), nil)
+	_, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "DELETE", fmt.Sprintf("/catalog/%s%s", id, catalogRefQuery(state.Ref)), nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to delete view, got error: %s", err),
@@ -93,6 +91,7 @@ func (r *dremioView) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 		MarkdownDescription: "Dremio View resource - manages a virtual dataset (view) in Dremio",
 
 		Attributes: map[string]schema.Attribute{
+			"project_id": projectIDAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the view",
 				Computed:            true,
@@ -176,6 +175,13 @@ func (r *dremioView) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 					},
 				},
 			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Branch of a versioned source (Arctic or Nessie) the view is created on. Defaults to the default branch of the source.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"tag": schema.StringAttribute{
 				MarkdownDescription: "Version tag for optimistic concurrency control. This value changes with every update.",
 				Computed:            true,
@@ -205,7 +211,7 @@ func (r *dremioView) Create(ctx context.Context, req resource.CreateRequest, res
 	}
 
 	// Make API request
-	api_resp, err := r.client.RequestToDremio("POST", "/catalog", reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(data.ProjectID.ValueString(), "POST", "/catalog"+catalogRefQuery(data.Ref), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to create view, got error: %s", err),
@@ -255,10 +261,10 @@ func (r *dremioView) Read(ctx context.Context, req resource.ReadRequest, resp *r
 	id := state.ID.ValueString()
 
 	var viewResp models.ViewResponse
-	view_resp, err := r.client.RequestToDremio("GET", fmt.Sprintf("/catalog/%s", id), nil)
+	view_resp, err := r.client.RequestToDremioInProject(state.ProjectID.ValueString(), "GET", fmt.Sprintf("/catalog/%s%s", id, catalogRefQuery(state.Ref)), nil)
 	if err != nil {
 		// If resource is not found (404), remove it from state so Terraform will recreate it
-		if strings.Contains(err.Error(), "status 404") {
+		if dremioClient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("View %s not found, removing from state", id))
 			resp.State.RemoveResource(ctx)
 			return
@@ -325,7 +331,7 @@ func (r *dremioView) Update(ctx context.Context, req resource.UpdateRequest, res
 
 	tflog.Debug(ctx, fmt.Sprintf("View update request with ID: %s, and Tag: %s", id, reqBody.Tag))
 
-	api_resp, err := r.client.RequestToDremio("PUT", fmt.Sprintf("/catalog/%s", id), reqBody)
+	api_resp, err := r.client.RequestToDremioInProject(plan.ProjectID.ValueString(), "PUT", fmt.Sprintf("/catalog/%s%s", id, catalogRefQuery(plan.Ref)), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error", fmt.Sprintf("Unable to update view, got error: %s", err),
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// projectIDAttribute returns the schema of the optional project_id attribute of project-scoped resources
+func projectIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "ID of the Dremio Cloud project the resource belongs to. Defaults to the provider `project_id`. Changing this forces a new resource. Not supported on Dremio Software.",
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// importStateInProject imports a project-scoped resource. The import ID is either the ID of the
+// resource in the provider project, or `<project_id>/<id>` for a resource in another project.
+func importStateInProject(ctx context.Context, idAttribute string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, id, found := strings.Cut(req.ID, "/")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root(idAttribute), req, resp)
+		return
+	}
+
+	if projectID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <id> or <project_id>/<id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttribute), id)...)
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	dremioClient "github.com/carlos-ffs/dremio-terraform-provider/internal/client"
+	"github.com/carlos-ffs/dremio-terraform-provider/internal/models"
+)
+
+// runSQLJob submits a SQL statement and waits up to timeout for its job to complete.
+// It returns the job ID; a job that does not complete returns a *dremioClient.JobError.
+func runSQLJob(ctx context.Context, client *dremioClient.Client, projectID string, sqlReq models.SQLRequest, timeout time.Duration) (string, error) {
+	jobID, err := client.SubmitSQL(projectID, sqlReq)
+	if err != nil {
+		return "", err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if _, err := client.WaitForJob(waitCtx, projectID, jobID); err != nil {
+		return jobID, err
+	}
+	return jobID, nil
+}
+
+// quoteSQLIdentifier quotes a SQL identifier, escaping the double quotes it contains
+func quoteSQLIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
+
+// quoteSQLPath quotes every element of a catalog path and joins them with dots
+func quoteSQLPath(path []string) string {
+	quoted := make([]string, len(path))
+	for i, element := range path {
+		quoted[i] = quoteSQLIdentifier(element)
+	}
+	return strings.Join(quoted, ".")
+}
+
+// quoteSQLString quotes a SQL string literal, escaping the single quotes it contains
+func quoteSQLString(value string) string {
+	return `'` + strings.ReplaceAll(value, `'`, `''`) + `'`
+}